@@ -2,7 +2,12 @@ package parserTypes
 
 import (
 	"SubmissionGrader/internal/parser/parserTypes/list"
+	"encoding/json"
 	"encoding/xml"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
 )
 
 // Create a struct for use in the parser.
@@ -12,12 +17,42 @@ type rParser struct{}
 func NewRparser() IParser { return &rParser{} }
 
 // Coverage
+// Reads the output of covr, which is either the Cobertura XML from covr::to_cobertura
+// or the JSON from covr::to_codecov, and returns the totals of every file
 func (t rParser) CoverageParser(location string) (CoverageResultsRawType, error) {
-	coverageResults := CoverageResultsRawType{
+	report, err := t.ParseCoverageReport(location)
+	if err != nil {
+		return CoverageResultsRawType{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, err
+	}
+	return report.Total, nil
+}
+
+// Coverage report with the breakdown of each file
+type RCoverageReport struct {
+	Total CoverageResultsRawType
+	Files map[string]CoverageResultsRawType
+}
+
+// ParseCoverageReport
+// Same as CoverageParser but keeps the results of each file
+func (t rParser) ParseCoverageReport(location string) (RCoverageReport, error) {
+	content, err := os.ReadFile(location)
+	if err != nil {
+		return RCoverageReport{}, err
+	}
+
+	if strings.HasSuffix(location, ".json") {
+		return t.codecovCoverageParser(content)
+	}
+	return t.coberturaCoverageParser(content)
+}
+
+func newRCoverageResults() CoverageResultsRawType {
+	return CoverageResultsRawType{
 		MissedLines:         0,
 		CoveredLines:        0,
-		MissedFunctions:     -1,
-		CoveredFunctions:    -1,
+		MissedFunctions:     0,
+		CoveredFunctions:    0,
 		MissedBranches:      0,
 		CoveredBranches:     0,
 		MissedInstructions:  -1,
@@ -25,8 +60,107 @@ func (t rParser) CoverageParser(location string) (CoverageResultsRawType, error)
 		MissedComplexity:    -1,
 		CoveredComplexity:   -1,
 	}
-	//Parse coverage results
-	return coverageResults, nil
+}
+
+// Parses the Cobertura XML of covr::to_cobertura
+func (t rParser) coberturaCoverageParser(content []byte) (RCoverageReport, error) {
+	var query RCoverage
+	err := xml.Unmarshal(content, &query.Chan)
+	if err != nil {
+		return RCoverageReport{}, err
+	}
+	queriedObject := query.Chan
+
+	report := RCoverageReport{
+		Total: newRCoverageResults(),
+		Files: map[string]CoverageResultsRawType{},
+	}
+	for _, coveragePackage := range queriedObject.Packages {
+		for _, coverageClass := range coveragePackage.Classes {
+			fileName := coverageClass.FileName
+			if fileName == "" {
+				fileName = coverageClass.Name
+			}
+			fileResults, ok := report.Files[fileName]
+			if !ok {
+				fileResults = newRCoverageResults()
+			}
+
+			for _, line := range coverageClass.Lines {
+				if hits, _ := strconv.Atoi(line.Hits); hits > 0 {
+					fileResults.CoveredLines++
+				} else {
+					fileResults.MissedLines++
+				}
+			}
+			// A function counts as covered when any of its lines has been ran
+			for _, method := range coverageClass.Methods {
+				covered := false
+				for _, line := range method.Lines {
+					if hits, _ := strconv.Atoi(line.Hits); hits > 0 {
+						covered = true
+						break
+					}
+				}
+				if covered {
+					fileResults.CoveredFunctions++
+				} else {
+					fileResults.MissedFunctions++
+				}
+			}
+
+			report.Files[fileName] = fileResults
+			report.Total.CoveredLines += fileResults.CoveredLines
+			report.Total.MissedLines += fileResults.MissedLines
+			report.Total.CoveredFunctions += fileResults.CoveredFunctions
+			report.Total.MissedFunctions += fileResults.MissedFunctions
+		}
+	}
+
+	// covr only reports branches for the whole project
+	AllBranches, _ := strconv.Atoi(queriedObject.BranchesValid)
+	CoveredBranches, _ := strconv.Atoi(queriedObject.BranchesCovered)
+	report.Total.CoveredBranches = CoveredBranches
+	report.Total.MissedBranches = AllBranches - CoveredBranches
+
+	return report, nil
+}
+
+// Parses the JSON of covr::to_codecov, which has the hits of every line of a file
+// (null when the line can't be ran). It has no notion of functions.
+func (t rParser) codecovCoverageParser(content []byte) (RCoverageReport, error) {
+	var query RCodecovCoverage
+	err := json.Unmarshal(content, &query)
+	if err != nil {
+		return RCoverageReport{}, err
+	}
+
+	report := RCoverageReport{
+		Total: newRCoverageResults(),
+		Files: map[string]CoverageResultsRawType{},
+	}
+	report.Total.MissedFunctions = -1
+	report.Total.CoveredFunctions = -1
+
+	for fileName, lines := range query.Coverage {
+		fileResults := newRCoverageResults()
+		fileResults.MissedFunctions = -1
+		fileResults.CoveredFunctions = -1
+		for _, hits := range lines {
+			if hits == nil {
+				continue
+			} else if *hits > 0 {
+				fileResults.CoveredLines++
+			} else {
+				fileResults.MissedLines++
+			}
+		}
+		report.Files[fileName] = fileResults
+		report.Total.CoveredLines += fileResults.CoveredLines
+		report.Total.MissedLines += fileResults.MissedLines
+	}
+
+	return report, nil
 }
 
 // Actual file parsiing
@@ -47,6 +181,44 @@ func (t rParser) FileParse(bytes []byte) (list.TestsLinkedList, error) {
 	return listResults, nil
 }
 
+type RCoverage struct {
+	Chan RCoverageTotal `xml:"coverage"`
+}
+
+type RCoverageTotal struct {
+	LinesValid      string             `xml:"lines-valid,attr"`
+	LinesCovered    string             `xml:"lines-covered,attr"`
+	BranchesValid   string             `xml:"branches-valid,attr"`
+	BranchesCovered string             `xml:"branches-covered,attr"`
+	Packages        []RCoveragePackage `xml:"packages>package"`
+}
+
+type RCoveragePackage struct {
+	Name    string           `xml:"name,attr"`
+	Classes []RCoverageClass `xml:"classes>class"`
+}
+
+type RCoverageClass struct {
+	Name     string            `xml:"name,attr"`
+	FileName string            `xml:"filename,attr"`
+	Methods  []RCoverageMethod `xml:"methods>method"`
+	Lines    []RCoverageLine   `xml:"lines>line"`
+}
+
+type RCoverageMethod struct {
+	Name  string          `xml:"name,attr"`
+	Lines []RCoverageLine `xml:"lines>line"`
+}
+
+type RCoverageLine struct {
+	Number string `xml:"number,attr"`
+	Hits   string `xml:"hits,attr"`
+}
+
+type RCodecovCoverage struct {
+	Coverage map[string][]*int `json:"coverage"`
+}
+
 type RQuery struct {
 	Chan RSuites `xml:"testsuites"`
 }
@@ -56,23 +228,31 @@ type RSuites struct {
 }
 
 type RUnitTests struct {
-	Errors    string      `xml:"errors,attr"`
-	Failures  string      `xml:"failures,attr"`
-	Skipped   string      `xml:"skipped,attr"`
-	Tests     string      `xml:"tests,attr"`
-	TestCases []RTestCase `xml:"testcase"`
+	Name      string        `xml:"name,attr"`
+	Errors    string        `xml:"errors,attr"`
+	Failures  string        `xml:"failures,attr"`
+	Skipped   string        `xml:"skipped,attr"`
+	Tests     string        `xml:"tests,attr"`
+	TestCases []RTestCase   `xml:"testcase"`
+	Error     *RErrorStatus `xml:"error"`
+	SystemErr string        `xml:"system-err"`
 }
 
 type RTestCase struct {
-	ClassName string         `xml:"classname,attr"`
-	Name      string         `xml:"name,attr"`
-	Skip      RSkipStatus    `xml:"skipped"`
-	Error     RErrorStatus   `xml:"error"`
-	Fail      RFailureStatus `xml:"failure"`
+	ClassName string          `xml:"classname,attr"`
+	Name      string          `xml:"name,attr"`
+	Time      string          `xml:"time,attr"`
+	Skip      *RSkipStatus    `xml:"skipped"`
+	Error     *RErrorStatus   `xml:"error"`
+	Fail      *RFailureStatus `xml:"failure"`
+	SystemOut string          `xml:"system-out"`
+	SystemErr string          `xml:"system-err"`
 }
 
+// The body of a failure or error is where the assertion diff and stack trace end up
 type RFailureStatus struct {
 	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
 }
 
 type RSkipStatus struct {
@@ -81,6 +261,8 @@ type RSkipStatus struct {
 
 type RErrorStatus struct {
 	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",chardata"`
 }
 
 func (s RSuites) ListFormat() list.TestsLinkedList {
@@ -93,6 +275,9 @@ func (s RSuites) ListFormat() list.TestsLinkedList {
 			thisTest := testCase.TestFormat()
 			listResults.AddTest(testCase.ClassName, thisTest)
 		}
+		if suiteError, hasError := testSuite.SuiteErrorFormat(); hasError {
+			listResults.AddTest(testSuite.Name, suiteError)
+		}
 	}
 
 	return listResults
@@ -104,26 +289,62 @@ func (s RTestCase) TestFormat() list.UnitTest {
 
 	passFailStatus := "PASSED"
 
-	failMessage := ""
-	failMessage = s.Fail.Message
-	skipMessage := ""
-	skipMessage = s.Skip.Message
-	errorMessage := ""
-	errorMessage = s.Error.Message
-
-	if failMessage != "" {
+	// The elements are checked instead of their messages since
+	// reporters like jest-junit leave the message attribute out
+	if s.Fail != nil {
 		passFailStatus = "FAILED"
-		thisTest.Message = failMessage
-	} else if skipMessage != "" {
+		thisTest.Message = getMessageOrFirstLine(s.Fail.Message, s.Fail.Body)
+		thisTest.StackTrace = strings.TrimSpace(s.Fail.Body)
+	} else if s.Skip != nil {
 		passFailStatus = "SKIPPED"
-	} else if errorMessage != "" {
+	} else if s.Error != nil {
 		passFailStatus = "ERRORED"
+		if s.Error.Type == TimeoutErrorType {
+			passFailStatus = OutcomeTimeout
+		}
+		thisTest.Message = getMessageOrFirstLine(s.Error.Message, s.Error.Body)
+		thisTest.StackTrace = strings.TrimSpace(s.Error.Body)
 	} else {
 		thisTest.Message = ""
 	}
 
 	thisTest.Outcome = passFailStatus
 	thisTest.Name = s.Name
+	thisTest.Duration, _ = strconv.ParseFloat(s.Time, 64)
+	thisTest.SystemOut = strings.TrimSpace(s.SystemOut)
+	thisTest.SystemErr = strings.TrimSpace(s.SystemErr)
 
 	return thisTest
 }
+
+// SuiteErrorFormat
+// When a test file fails to even run (such as not compiling), the suite reports
+// errors without a test case for each of them. Those errors become one ERRORED
+// test named after the suite so the submission is not shown as passing
+func (s RUnitTests) SuiteErrorFormat() (list.UnitTest, bool) {
+	errorCount, _ := strconv.Atoi(s.Errors)
+	reportedErrors := 0
+	for _, testCase := range s.TestCases {
+		if testCase.Error != nil {
+			reportedErrors++
+		}
+	}
+	if errorCount <= reportedErrors {
+		return list.UnitTest{}, false
+	}
+
+	thisTest := list.UnitTest{}
+	thisTest.Outcome = "ERRORED"
+	thisTest.Name = s.Name
+	if s.Error != nil {
+		thisTest.Message = getMessageOrFirstLine(s.Error.Message, s.Error.Body)
+		thisTest.StackTrace = strings.TrimSpace(s.Error.Body)
+	} else if strings.TrimSpace(s.SystemErr) != "" {
+		thisTest.Message = getMessageOrFirstLine("", s.SystemErr)
+		thisTest.StackTrace = strings.TrimSpace(s.SystemErr)
+	} else {
+		thisTest.Message = fmt.Sprintf("Test suite %s failed to run", s.Name)
+	}
+
+	return thisTest, true
+}
@@ -0,0 +1,196 @@
+package parserTypes
+
+import (
+	"SubmissionGrader/internal/parser/parserTypes/list"
+	"reflect"
+	"testing"
+)
+
+const covrCobertura = `<?xml version="1.0" encoding="UTF-8"?>
+<coverage line-rate="0.6" branch-rate="0.5" lines-covered="3" lines-valid="5" branches-covered="1" branches-valid="2" complexity="0" version="3.6.4">
+  <packages>
+    <package name="stack" line-rate="0.6" branch-rate="0.5" complexity="0">
+      <classes>
+        <class name="stack.R" filename="R/stack.R" line-rate="0.75" branch-rate="0.5" complexity="0">
+          <methods>
+            <method name="push" signature="" line-rate="1" branch-rate="0" complexity="0">
+              <lines>
+                <line number="2" hits="4"/>
+                <line number="3" hits="4"/>
+              </lines>
+            </method>
+            <method name="peek" signature="" line-rate="0" branch-rate="0" complexity="0">
+              <lines>
+                <line number="6" hits="0"/>
+              </lines>
+            </method>
+          </methods>
+          <lines>
+            <line number="2" hits="4" branch="false"/>
+            <line number="3" hits="4" branch="false"/>
+            <line number="6" hits="0" branch="false"/>
+            <line number="9" hits="1" branch="false"/>
+          </lines>
+        </class>
+        <class name="queue.R" filename="R/queue.R" line-rate="0" branch-rate="0" complexity="0">
+          <methods>
+            <method name="enqueue" signature="" line-rate="0" branch-rate="0" complexity="0">
+              <lines>
+                <line number="1" hits="0"/>
+              </lines>
+            </method>
+          </methods>
+          <lines>
+            <line number="1" hits="0" branch="false"/>
+          </lines>
+        </class>
+      </classes>
+    </package>
+  </packages>
+</coverage>
+`
+
+const covrCodecov = `{
+  "coverage": {
+    "R/stack.R": [null, 4, 4, null, null, 0, null, null, 1],
+    "R/queue.R": [0, null]
+  }
+}
+`
+
+// coverage
+// Builds the coverage results the R parser gives, where instructions and complexity are unknown
+func coverage(missedLines int, coveredLines int, missedFunctions int, coveredFunctions int, missedBranches int, coveredBranches int) CoverageResultsRawType {
+	return CoverageResultsRawType{
+		MissedLines:         missedLines,
+		CoveredLines:        coveredLines,
+		MissedFunctions:     missedFunctions,
+		CoveredFunctions:    coveredFunctions,
+		MissedBranches:      missedBranches,
+		CoveredBranches:     coveredBranches,
+		MissedInstructions:  -1,
+		CoveredInstructions: -1,
+		MissedComplexity:    -1,
+		CoveredComplexity:   -1,
+	}
+}
+
+func TestRParseCoverageReport(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+		want     RCoverageReport
+	}{
+		{
+			name:     "cobertura",
+			fileName: "coverage.xml",
+			content:  covrCobertura,
+			want: RCoverageReport{
+				Total: coverage(2, 3, 2, 1, 1, 1),
+				Files: map[string]CoverageResultsRawType{
+					"R/stack.R": coverage(1, 3, 1, 1, 0, 0),
+					"R/queue.R": coverage(1, 0, 1, 0, 0, 0),
+				},
+			},
+		},
+		{
+			name:     "codecov json has no functions",
+			fileName: "coverage.json",
+			content:  covrCodecov,
+			want: RCoverageReport{
+				Total: coverage(2, 3, -1, -1, 0, 0),
+				Files: map[string]CoverageResultsRawType{
+					"R/stack.R": coverage(1, 3, -1, -1, 0, 0),
+					"R/queue.R": coverage(1, 0, -1, -1, 0, 0),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			location := writeFile(t, test.fileName, test.content)
+			got, err := rParser{}.ParseCoverageReport(location)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ParseCoverageReport() = %+v, want %+v", got, test.want)
+			}
+
+			total, err := rParser{}.CoverageParser(location)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != test.want.Total {
+				t.Errorf("CoverageParser() = %+v, want %+v", total, test.want.Total)
+			}
+		})
+	}
+}
+
+func TestRCoverageParserErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+	}{
+		{name: "malformed xml", fileName: "coverage.xml", content: "<coverage"},
+		{name: "malformed json", fileName: "coverage.json", content: "{"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := rParser{}.CoverageParser(writeFile(t, test.fileName, test.content))
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if got != (CoverageResultsRawType{}) {
+				t.Errorf("CoverageParser() = %+v, want all zeros", got)
+			}
+		})
+	}
+}
+
+func TestRFileParse(t *testing.T) {
+	report := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="testthat" tests="4" skipped="1" failures="1" errors="1" time="0.3">
+  <testsuite name="stack" timestamp="2024-01-01T00:00:00" hostname="grader" tests="4" skipped="1" failures="1" errors="1" time="0.3">
+    <testcase time="0.01" classname="stack" name="push_adds_an_item"/>
+    <testcase time="0.02" classname="stack" name="pop_removes_the_last_item">
+      <failure type="failure" message="pop(s) not equal to 2.">pop(s) not equal to 2.
+1/1 mismatches
+[1] 1 - 2 == -1</failure>
+    </testcase>
+    <testcase time="0" classname="stack" name="peek_is_skipped">
+      <skipped type="skipped" message="Reason: not done yet"/>
+    </testcase>
+    <testcase time="0.1" classname="stack" name="size_works">
+      <error type="error" message="could not find function &quot;size&quot;">Error in size(s): could not find function "size"</error>
+    </testcase>
+  </testsuite>
+  <testsuite name="queue" tests="0" skipped="0" failures="0" errors="1" time="0">
+    <error message="Error in source(&quot;queue.R&quot;): cannot open file">Error in source("queue.R"): cannot open file</error>
+  </testsuite>
+</testsuites>
+`
+	want := []list.UnitTest{
+		{Name: "push_adds_an_item", Outcome: "PASSED", Duration: 0.01},
+		{Name: "pop_removes_the_last_item", Outcome: "FAILED", Message: "pop(s) not equal to 2.", Duration: 0.02,
+			StackTrace: "pop(s) not equal to 2.\n1/1 mismatches\n[1] 1 - 2 == -1"},
+		{Name: "peek_is_skipped", Outcome: "SKIPPED"},
+		{Name: "size_works", Outcome: "ERRORED", Message: `could not find function "size"`, Duration: 0.1,
+			StackTrace: `Error in size(s): could not find function "size"`},
+		{Name: "queue", Outcome: "ERRORED", Message: `Error in source("queue.R"): cannot open file`,
+			StackTrace: `Error in source("queue.R"): cannot open file`},
+	}
+
+	tests, err := rParser{}.FileParse([]byte(report))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, got := listTests(tests)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FileParse() = %+v, want %+v", got, want)
+	}
+}
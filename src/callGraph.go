@@ -0,0 +1,359 @@
+package complexCommons
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Receivers of a call that are not the name of a class
+const (
+	CallReceiverThis  = "this"
+	CallReceiverSuper = "super" // super.name() and super(), which calls the constructor
+)
+
+// FunctionInfo
+// A function or method declared in a file. Key is where it is in the file, such as
+// Class.method or outer->lambda#1, and Scope is the key of the function it is declared in
+// (empty at the top of the file, the class for methods)
+type FunctionInfo struct {
+	Key       string
+	Name      string
+	Class     string
+	Scope     string
+	Member    bool // a method of Class, which is only reached through this, super or the class
+	StartLine int
+	Locals    map[string]bool // parameters, variables and functions declared directly in it
+}
+
+// CallInfo
+// A call as it was written: name() has no receiver, this.name() and super.name()
+// have this and super, and Class.name() has the name of the class
+type CallInfo struct {
+	Caller   string // key of the function the call is in
+	Class    string // class the caller is in, for this and super
+	Receiver string
+	Name     string
+	Line     int
+}
+
+// FileCalls
+// The functions of one file and the calls they make
+type FileCalls struct {
+	FileName  string
+	Functions []FunctionInfo
+	Calls     []CallInfo
+	Imports   []ImportInfo      // used to find functions of other files
+	Extends   map[string]string // the name of each class to the name of the class it extends
+}
+
+// CallGraphNode
+// A function of the submission. ID is the file name and key, as in src/app.ts:App.start
+type CallGraphNode struct {
+	ID              string `json:"id"`
+	FileName        string `json:"file"`
+	Key             string `json:"key"`
+	Class           string `json:"class"`
+	Name            string `json:"name"`
+	Line            int    `json:"line"`
+	DirectRecursion bool   `json:"directRecursion"` // calls itself
+	MutualRecursion bool   `json:"mutualRecursion"` // calls itself through other functions
+}
+
+// CallGraphEdge
+// A function calling another, Line is the first of the Calls it makes
+type CallGraphEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Line  int    `json:"line"`
+	Calls int    `json:"calls"`
+}
+
+// CallGraph
+// The calls between the functions of a submission. Calls that could not be resolved to one of
+// its functions, such as items.push(x) or calls to packages, are only counted
+type CallGraph struct {
+	Nodes      []CallGraphNode `json:"nodes"`
+	Edges      []CallGraphEdge `json:"edges"`
+	Cycles     [][]string      `json:"cycles"` // functions that are mutually recursive, by ID
+	Unresolved int             `json:"unresolved"`
+}
+
+// GetRecursiveNodes
+// Returns every function that is directly or mutually recursive
+func (g CallGraph) GetRecursiveNodes() []CallGraphNode {
+	nodes := []CallGraphNode{}
+	for _, node := range g.Nodes {
+		if node.DirectRecursion || node.MutualRecursion {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// callResolver
+// Finds the function a call is made to, the way the language would
+type callResolver struct {
+	functions map[string]*FunctionInfo   // by ID
+	fileNames map[string]string          // the file of each function, by ID
+	scopes    map[string][]*FunctionInfo // functions that are not members, by file and scope
+	members   map[string][]string        // IDs of the methods of each class
+	extends   map[string]string
+	imports   map[string][]string // the files each file imports
+}
+
+// BuildCallGraph
+// Resolves every call to the function it is made to and marks the recursive functions.
+// A plain name is looked up from the caller outwards, so a parameter, variable or nested
+// function of the same name hides the function further out, then in the files imported
+// by the file. this and super are looked up in the class and the classes it extends
+func BuildCallGraph(files []FileCalls) CallGraph {
+	graph := CallGraph{Nodes: []CallGraphNode{}, Edges: []CallGraphEdge{}}
+	resolver := newCallResolver(files)
+
+	ids := make([]string, 0, len(resolver.functions))
+	for id := range resolver.functions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	edges := map[string]*CallGraphEdge{}
+	var edgeOrder []string
+	adjacent := map[string][]string{}
+	for _, file := range files {
+		for _, call := range file.Calls {
+			target, ok := resolver.resolve(file.FileName, call)
+			if !ok {
+				graph.Unresolved++
+				continue
+			}
+			from := getFunctionID(file.FileName, call.Caller)
+			key := from + "\x00" + target
+			if edge, found := edges[key]; found {
+				edge.Calls++
+				continue
+			}
+			edges[key] = &CallGraphEdge{From: from, To: target, Line: call.Line, Calls: 1}
+			edgeOrder = append(edgeOrder, key)
+			adjacent[from] = append(adjacent[from], target)
+		}
+	}
+	for _, key := range edgeOrder {
+		graph.Edges = append(graph.Edges, *edges[key])
+	}
+
+	mutual := map[string]bool{}
+	graph.Cycles = [][]string{}
+	for _, component := range findStronglyConnectedComponents(ids, adjacent) {
+		if len(component) < 2 {
+			continue
+		}
+		graph.Cycles = append(graph.Cycles, component)
+		for _, id := range component {
+			mutual[id] = true
+		}
+	}
+
+	for _, id := range ids {
+		function := resolver.functions[id]
+		graph.Nodes = append(graph.Nodes, CallGraphNode{
+			ID:              id,
+			FileName:        resolver.fileNames[id],
+			Key:             function.Key,
+			Class:           function.Class,
+			Name:            function.Name,
+			Line:            function.StartLine,
+			DirectRecursion: edges[id+"\x00"+id] != nil,
+			MutualRecursion: mutual[id],
+		})
+	}
+	return graph
+}
+
+func newCallResolver(files []FileCalls) *callResolver {
+	resolver := &callResolver{
+		functions: map[string]*FunctionInfo{},
+		fileNames: map[string]string{},
+		scopes:    map[string][]*FunctionInfo{},
+		members:   map[string][]string{},
+		extends:   map[string]string{},
+		imports:   map[string][]string{},
+	}
+	known := map[string]bool{}
+	for _, file := range files {
+		known[file.FileName] = true
+	}
+
+	for _, file := range files {
+		for i := range file.Functions {
+			function := &file.Functions[i]
+			id := getFunctionID(file.FileName, function.Key)
+			resolver.functions[id] = function
+			resolver.fileNames[id] = file.FileName
+			if function.Member {
+				resolver.members[function.Class] = append(resolver.members[function.Class], id)
+			} else {
+				scope := file.FileName + "\x00" + function.Scope
+				resolver.scopes[scope] = append(resolver.scopes[scope], function)
+			}
+		}
+		for class, parent := range file.Extends {
+			resolver.extends[class] = parent
+		}
+		for _, info := range file.Imports {
+			if !info.IsRelative() {
+				continue
+			}
+			if target, missing := resolveRelativeImport(file.FileName, info.Module, known); !missing {
+				resolver.imports[file.FileName] = append(resolver.imports[file.FileName], target)
+			}
+		}
+	}
+	return resolver
+}
+
+// resolve
+// Gets the ID of the function the call is made to
+func (r *callResolver) resolve(fileName string, call CallInfo) (string, bool) {
+	switch {
+	case call.Receiver == "":
+		return r.resolveName(fileName, call)
+	case call.Receiver == CallReceiverThis:
+		return r.resolveMember(fileName, call.Class, call.Name)
+	case call.Receiver == CallReceiverSuper:
+		return r.resolveMember(fileName, r.extends[call.Class], call.Name)
+	case len(r.members[call.Receiver]) > 0: // static method, Class.name()
+		return r.resolveMember(fileName, call.Receiver, call.Name)
+	}
+	return "", false
+}
+
+// resolveName
+// Goes out one scope at a time from the caller to the top of the file
+func (r *callResolver) resolveName(fileName string, call CallInfo) (string, bool) {
+	scope := call.Caller
+	for {
+		if id, ok := r.findInScope(fileName, scope, call.Name); ok {
+			return id, true
+		}
+		function, isFunction := r.functions[getFunctionID(fileName, scope)]
+		if isFunction && function.Locals[call.Name] { // hidden by something that is not a function
+			return "", false
+		}
+		if scope == "" {
+			break
+		}
+		if isFunction {
+			scope = function.Scope
+		} else { // a class, which is in the scope its key starts with
+			scope = getParentLocation(scope)
+		}
+	}
+
+	for _, imported := range r.imports[fileName] {
+		if id, ok := r.findInScope(imported, "", call.Name); ok {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+func (r *callResolver) findInScope(fileName string, scope string, name string) (string, bool) {
+	for _, function := range r.scopes[fileName+"\x00"+scope] {
+		if function.Name == name {
+			return getFunctionID(fileName, function.Key), true
+		}
+	}
+	return "", false
+}
+
+// resolveMember
+// Looks for the method in the class, then in the classes it extends. Classes are known
+// by name only, so a class of the same file is preferred when two files use the same name
+func (r *callResolver) resolveMember(fileName string, class string, name string) (string, bool) {
+	seen := map[string]bool{}
+	for class != "" && !seen[class] {
+		seen[class] = true
+		found := ""
+		for _, id := range r.members[class] {
+			if r.functions[id].Name != name {
+				continue
+			}
+			if r.fileNames[id] == fileName {
+				return id, true
+			}
+			if found == "" {
+				found = id
+			}
+		}
+		if found != "" {
+			return found, true
+		}
+		class = r.extends[class]
+	}
+	return "", false
+}
+
+func getFunctionID(fileName string, key string) string {
+	return fileName + ":" + key
+}
+
+// getParentLocation
+// Goes from a location such as outer->Inner to outer
+func getParentLocation(location string) string {
+	index := strings.LastIndex(location, "->")
+	if index < 0 {
+		return ""
+	}
+	return location[:index]
+}
+
+// ToJSON
+// Writes the graph as indented JSON
+func (g CallGraph) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// ToDOT
+// Writes the graph in the DOT language of Graphviz, with the functions grouped by file.
+// Recursive functions and the calls that make them recursive are drawn in red
+func (g CallGraph) ToDOT() string {
+	inCycle := map[string]int{}
+	for i, cycle := range g.Cycles {
+		for _, id := range cycle {
+			inCycle[id] = i + 1
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString("digraph calls {\n")
+	cluster := 0
+	for i, node := range g.Nodes {
+		if i == 0 || g.Nodes[i-1].FileName != node.FileName {
+			if i > 0 {
+				builder.WriteString("  }\n")
+			}
+			builder.WriteString(fmt.Sprintf("  subgraph cluster_%d {\n    label=%q;\n", cluster, node.FileName))
+			cluster++
+		}
+		attributes := ""
+		if node.DirectRecursion || node.MutualRecursion {
+			attributes = ", color=red"
+		}
+		builder.WriteString(fmt.Sprintf("    %q [label=%q%s];\n", node.ID, node.Key, attributes))
+	}
+	if len(g.Nodes) > 0 {
+		builder.WriteString("  }\n")
+	}
+
+	for _, edge := range g.Edges {
+		attributes := ""
+		if edge.From == edge.To || (inCycle[edge.From] != 0 && inCycle[edge.From] == inCycle[edge.To]) {
+			attributes = " [color=red]"
+		}
+		builder.WriteString(fmt.Sprintf("  %q -> %q%s;\n", edge.From, edge.To, attributes))
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
@@ -0,0 +1,157 @@
+package complexCommons
+
+import (
+	"fmt"
+	"testing"
+)
+
+// testFunction
+// A function declared at the top of the file
+func testFunction(name string, locals ...string) FunctionInfo {
+	function := FunctionInfo{Key: name, Name: name, Locals: map[string]bool{}}
+	for _, local := range locals {
+		function.Locals[local] = true
+	}
+	return function
+}
+
+// testMember
+// A method of the class
+func testMember(class string, name string) FunctionInfo {
+	return FunctionInfo{Key: class + "." + name, Name: name, Class: class, Scope: class, Member: true, Locals: map[string]bool{}}
+}
+
+// testCalls
+// Makes the calls of a file from caller>name pairs, name being receiver.name for this, super and classes
+func testCalls(fileName string, functions []FunctionInfo, calls ...string) FileCalls {
+	file := FileCalls{FileName: fileName, Functions: functions, Extends: map[string]string{}}
+	for i, call := range calls {
+		var caller, name string
+		fmt.Sscanf(call, "%s > %s", &caller, &name)
+		info := CallInfo{Caller: caller, Name: name, Line: i + 1}
+		for _, function := range functions {
+			if function.Key == caller {
+				info.Class = function.Class
+			}
+		}
+		for j := len(name) - 1; j >= 0; j-- {
+			if name[j] == '.' {
+				info.Receiver, info.Name = name[:j], name[j+1:]
+				break
+			}
+		}
+		file.Calls = append(file.Calls, info)
+	}
+	return file
+}
+
+func TestBuildCallGraphCycles(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      []FileCalls
+		cycles     [][]string
+		direct     []string
+		unresolved int
+	}{
+		{
+			name:   "no recursion",
+			files:  []FileCalls{testCalls("a.ts", []FunctionInfo{testFunction("f"), testFunction("g")}, "f > g")},
+			cycles: [][]string{},
+		},
+		{
+			name:   "direct recursion",
+			files:  []FileCalls{testCalls("a.ts", []FunctionInfo{testFunction("factorial")}, "factorial > factorial")},
+			cycles: [][]string{},
+			direct: []string{"a.ts:factorial"},
+		},
+		{
+			name:   "mutual recursion",
+			files:  []FileCalls{testCalls("a.ts", []FunctionInfo{testFunction("isEven"), testFunction("isOdd")}, "isEven > isOdd", "isOdd > isEven")},
+			cycles: [][]string{{"a.ts:isEven", "a.ts:isOdd"}},
+		},
+		{
+			name: "a cycle of three leading out to another function",
+			files: []FileCalls{testCalls("a.ts", []FunctionInfo{testFunction("a"), testFunction("b"), testFunction("c"), testFunction("d")},
+				"a > b", "b > c", "c > a", "c > d")},
+			cycles: [][]string{{"a.ts:a", "a.ts:b", "a.ts:c"}},
+		},
+		{
+			name: "two separate cycles and a recursive function in one of them",
+			files: []FileCalls{testCalls("a.ts", []FunctionInfo{testFunction("a"), testFunction("b"), testFunction("c"), testFunction("d")},
+				"c > d", "d > c", "a > b", "b > a", "b > b")},
+			cycles: [][]string{{"a.ts:a", "a.ts:b"}, {"a.ts:c", "a.ts:d"}},
+			direct: []string{"a.ts:b"},
+		},
+		{
+			name: "cycle between files through their imports",
+			files: []FileCalls{
+				withImports(testCalls("a.ts", []FunctionInfo{testFunction("parse")}, "parse > parseList"), "./b"),
+				withImports(testCalls("b.ts", []FunctionInfo{testFunction("parseList")}, "parseList > parse"), "./a"),
+			},
+			cycles: [][]string{{"a.ts:parse", "b.ts:parseList"}},
+		},
+		{
+			name: "methods calling each other through this",
+			files: []FileCalls{testCalls("shape.ts", []FunctionInfo{testMember("Shape", "area"), testMember("Shape", "size")},
+				"Shape.area > this.size", "Shape.size > this.area")},
+			cycles: [][]string{{"shape.ts:Shape.area", "shape.ts:Shape.size"}},
+		},
+		{
+			name: "calls that are hidden by a local or made on something else are not followed",
+			files: []FileCalls{testCalls("a.ts", []FunctionInfo{testFunction("f", "g"), testFunction("g")},
+				"f > g", "g > f", "g > items.push")},
+			cycles:     [][]string{},
+			unresolved: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			graph := BuildCallGraph(test.files)
+			if fmt.Sprint(graph.Cycles) != fmt.Sprint(test.cycles) {
+				t.Errorf("cycles = %v, want %v", graph.Cycles, test.cycles)
+			}
+			if graph.Unresolved != test.unresolved {
+				t.Errorf("unresolved = %d, want %d", graph.Unresolved, test.unresolved)
+			}
+
+			inCycle := map[string]bool{}
+			for _, cycle := range test.cycles {
+				for _, id := range cycle {
+					inCycle[id] = true
+				}
+			}
+			direct := map[string]bool{}
+			for _, id := range test.direct {
+				direct[id] = true
+			}
+			for _, node := range graph.Nodes {
+				if node.MutualRecursion != inCycle[node.ID] || node.DirectRecursion != direct[node.ID] {
+					t.Errorf("%s is mutually recursive %t and directly recursive %t, want %t and %t",
+						node.ID, node.MutualRecursion, node.DirectRecursion, inCycle[node.ID], direct[node.ID])
+				}
+			}
+			if len(graph.GetRecursiveNodes()) != len(unionOf(inCycle, direct)) {
+				t.Errorf("recursive nodes = %v, want %d", graph.GetRecursiveNodes(), len(unionOf(inCycle, direct)))
+			}
+		})
+	}
+}
+
+func withImports(file FileCalls, modules ...string) FileCalls {
+	for _, module := range modules {
+		file.Imports = append(file.Imports, ImportInfo{FileName: file.FileName, Module: module, Kind: ImportKindImport})
+	}
+	return file
+}
+
+func unionOf(first map[string]bool, second map[string]bool) map[string]bool {
+	union := map[string]bool{}
+	for id := range first {
+		union[id] = true
+	}
+	for id := range second {
+		union[id] = true
+	}
+	return union
+}
@@ -0,0 +1,146 @@
+package complexCommons
+
+import "sort"
+
+// ClassInfo
+// What a language parser found out about one class, used to work out its design metrics
+type ClassInfo struct {
+	Name       string
+	FileName   string
+	Extends    string // empty when the class does not extend anything
+	Implements []string
+	Fields     map[string]bool
+	Methods    []ClassMethodInfo
+	References map[string]bool // every name used inside the class, to find which other classes it uses
+}
+
+// ClassMethodInfo
+// A method declared directly in a class along with the fields it uses
+type ClassMethodInfo struct {
+	Name       string
+	CycCount   int
+	FieldsUsed map[string]bool
+}
+
+// ClassMetrics
+// The Chidamber and Kemerer metrics of one class
+type ClassMetrics struct {
+	Name     string
+	FileName string
+	WMC      int // weighted methods per class, the sum of the cyclomatic complexity of its methods
+	DIT      int // depth of inheritance tree, a parent that is not in the submission counts as one level
+	NOC      int // number of children, classes that directly extend it
+	LCOM     int // lack of cohesion, pairs of methods sharing no fields minus pairs sharing some (never below 0)
+	CBO      int // coupling between objects, other classes of the submission it uses or is used by
+}
+
+// ComputeClassMetrics
+// Works out the metrics of every class, which needs the classes of every file
+// in the submission since inheritance and coupling cross files
+func ComputeClassMetrics(classes []ClassInfo) []ClassMetrics {
+	classesByName := map[string]ClassInfo{}
+	children := map[string]int{}
+	for _, class := range classes {
+		classesByName[class.Name] = class
+		if class.Extends != "" {
+			children[class.Extends]++
+		}
+	}
+
+	coupling := map[string]map[string]bool{}
+	for _, class := range classes {
+		for reference := range class.References {
+			if _, known := classesByName[reference]; !known || reference == class.Name {
+				continue
+			}
+			addCoupling(coupling, class.Name, reference)
+			addCoupling(coupling, reference, class.Name)
+		}
+	}
+
+	metrics := make([]ClassMetrics, 0, len(classes))
+	for _, class := range classes {
+		classMetrics := ClassMetrics{
+			Name:     class.Name,
+			FileName: class.FileName,
+			DIT:      getInheritanceDepth(class, classesByName),
+			NOC:      children[class.Name],
+			LCOM:     getLackOfCohesion(class),
+			CBO:      len(coupling[class.Name]),
+		}
+		for _, method := range class.Methods {
+			classMetrics.WMC += method.CycCount
+		}
+		metrics = append(metrics, classMetrics)
+	}
+
+	sort.SliceStable(metrics, func(i, j int) bool {
+		if metrics[i].FileName != metrics[j].FileName {
+			return metrics[i].FileName < metrics[j].FileName
+		}
+		return metrics[i].Name < metrics[j].Name
+	})
+	return metrics
+}
+
+func addCoupling(coupling map[string]map[string]bool, from string, to string) {
+	if coupling[from] == nil {
+		coupling[from] = map[string]bool{}
+	}
+	coupling[from][to] = true
+}
+
+// getInheritanceDepth
+// Follows the classes it extends, stopping if the chain ever loops back on itself
+func getInheritanceDepth(class ClassInfo, classesByName map[string]ClassInfo) int {
+	depth := 0
+	seen := map[string]bool{class.Name: true}
+	for class.Extends != "" && !seen[class.Extends] {
+		depth++
+		parent, known := classesByName[class.Extends]
+		if !known {
+			break
+		}
+		seen[parent.Name] = true
+		class = parent
+	}
+	return depth
+}
+
+// getLackOfCohesion
+// Only names that are fields of the class count, so calls to its own methods (this.method()) are left out
+func getLackOfCohesion(class ClassInfo) int {
+	methodFields := make([]map[string]bool, len(class.Methods))
+	for i, method := range class.Methods {
+		methodFields[i] = map[string]bool{}
+		for field := range method.FieldsUsed {
+			if class.Fields[field] {
+				methodFields[i][field] = true
+			}
+		}
+	}
+
+	sharing, notSharing := 0, 0
+	for i := range methodFields {
+		for j := i + 1; j < len(methodFields); j++ {
+			if sharesField(methodFields[i], methodFields[j]) {
+				sharing++
+			} else {
+				notSharing++
+			}
+		}
+	}
+	if notSharing > sharing {
+		return notSharing - sharing
+	}
+	return 0
+}
+
+func sharesField(first map[string]bool, second map[string]bool) bool {
+	for field := range first {
+		if second[field] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,224 @@
+package complexCommons
+
+import "sort"
+
+// The kinds of clones, from the closest copy to the loosest
+const (
+	CloneTypeExact   = 1 // the same tokens, only whitespace and comments differ
+	CloneTypeRenamed = 2 // the same once names and literals are taken out
+	CloneTypeGapped  = 3 // close enough once names and literals are taken out, with statements added or removed
+)
+
+const (
+	// Methods shorter than this are left out, or every getter would be a clone of every other getter
+	MinimumCloneTokens = 30
+	// How much of two methods must line up for them to be gapped clones, from 0 to 1
+	GappedCloneSimilarity = 0.8
+)
+
+// MethodTokens
+// The tokens of one method, in the order they were recorded
+type MethodTokens struct {
+	FileName   string
+	Location   string
+	Class      string
+	MethodName string
+	StartLine  int
+	EndLine    int
+	Tokens     []NormalizedToken
+}
+
+// CloneMember
+// Where one of the copies of a clone group is
+type CloneMember struct {
+	FileName   string
+	Location   string
+	Class      string
+	MethodName string
+	StartLine  int
+	EndLine    int
+	TokenCount int
+}
+
+// CloneGroup
+// Methods that are copies of one another. Type is the loosest kind of clone
+// between any two of them, so a group mixing exact and renamed copies is a renamed clone
+type CloneGroup struct {
+	Type       int
+	Similarity float64 // the lowest similarity between two members that were matched
+	Members    []CloneMember
+}
+
+// CloneReport
+// DuplicationPercentage is the part of all method tokens, from 0 to 100, that are copies.
+// The largest member of each group is counted as the original and the rest as copies
+type CloneReport struct {
+	Groups                []CloneGroup
+	TotalTokens           int
+	DuplicatedTokens      int
+	DuplicationPercentage float64
+}
+
+// DetectClones
+// Compares every method with every other method of the submission
+func DetectClones(methods []MethodTokens) CloneReport {
+	report := CloneReport{Groups: []CloneGroup{}}
+	var candidates []int
+	for i, method := range methods {
+		report.TotalTokens += len(method.Tokens)
+		if len(method.Tokens) >= MinimumCloneTokens {
+			candidates = append(candidates, i)
+		}
+	}
+
+	parents := map[int]int{}
+	for _, i := range candidates {
+		parents[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+
+	type clonePair struct {
+		first      int
+		cloneType  int
+		similarity float64
+	}
+	var pairs []clonePair
+	for a := 0; a < len(candidates); a++ {
+		for b := a + 1; b < len(candidates); b++ {
+			first, second := candidates[a], candidates[b]
+			cloneType, similarity := getCloneType(methods[first].Tokens, methods[second].Tokens)
+			if cloneType == 0 {
+				continue
+			}
+			pairs = append(pairs, clonePair{first: first, cloneType: cloneType, similarity: similarity})
+			parents[find(first)] = find(second)
+		}
+	}
+
+	sizes := map[int]int{}
+	for _, i := range candidates {
+		sizes[find(i)]++
+	}
+	groupsByRoot := map[int]*CloneGroup{}
+	var roots []int
+	for _, i := range candidates {
+		root := find(i)
+		if sizes[root] < 2 {
+			continue
+		}
+		group, ok := groupsByRoot[root]
+		if !ok {
+			group = &CloneGroup{Similarity: 1}
+			groupsByRoot[root] = group
+			roots = append(roots, root)
+		}
+		method := methods[i]
+		group.Members = append(group.Members, CloneMember{
+			FileName:   method.FileName,
+			Location:   method.Location,
+			Class:      method.Class,
+			MethodName: method.MethodName,
+			StartLine:  method.StartLine,
+			EndLine:    method.EndLine,
+			TokenCount: len(method.Tokens),
+		})
+	}
+	for _, pair := range pairs {
+		group := groupsByRoot[find(pair.first)]
+		if pair.cloneType > group.Type {
+			group.Type = pair.cloneType
+		}
+		if pair.similarity < group.Similarity {
+			group.Similarity = pair.similarity
+		}
+	}
+
+	for _, root := range roots {
+		group := groupsByRoot[root]
+		largest := 0
+		for _, member := range group.Members {
+			report.DuplicatedTokens += member.TokenCount
+			if member.TokenCount > largest {
+				largest = member.TokenCount
+			}
+		}
+		report.DuplicatedTokens -= largest
+		report.Groups = append(report.Groups, *group)
+	}
+	if report.TotalTokens > 0 {
+		report.DuplicationPercentage = 100 * float64(report.DuplicatedTokens) / float64(report.TotalTokens)
+	}
+
+	sort.SliceStable(report.Groups, func(i, j int) bool {
+		first, second := report.Groups[i].Members[0], report.Groups[j].Members[0]
+		if first.FileName != second.FileName {
+			return first.FileName < second.FileName
+		}
+		return first.StartLine < second.StartLine
+	})
+	return report
+}
+
+// getCloneType
+// Returns 0 when the methods are not clones
+func getCloneType(first []NormalizedToken, second []NormalizedToken) (int, float64) {
+	if len(first) == len(second) {
+		exact, renamed := true, true
+		for i := range first {
+			if first[i].Text != second[i].Text {
+				renamed = false
+				break
+			}
+			if first[i].Original != second[i].Original {
+				exact = false
+			}
+		}
+		if exact && renamed {
+			return CloneTypeExact, 1
+		}
+		if renamed {
+			return CloneTypeRenamed, 1
+		}
+	}
+
+	// The longest common subsequence cannot be long enough if the lengths are too far apart
+	shorter, longer := len(first), len(second)
+	if shorter > longer {
+		shorter, longer = longer, shorter
+	}
+	if 2*float64(shorter)/float64(shorter+longer) < GappedCloneSimilarity {
+		return 0, 0
+	}
+	similarity := 2 * float64(getCommonSubsequenceLength(first, second)) / float64(len(first)+len(second))
+	if similarity < GappedCloneSimilarity {
+		return 0, 0
+	}
+	return CloneTypeGapped, similarity
+}
+
+// getCommonSubsequenceLength
+// The number of normalised tokens the two methods have in the same order, keeping only two rows of the table
+func getCommonSubsequenceLength(first []NormalizedToken, second []NormalizedToken) int {
+	previous := make([]int, len(second)+1)
+	current := make([]int, len(second)+1)
+	for i := 1; i <= len(first); i++ {
+		for j := 1; j <= len(second); j++ {
+			switch {
+			case first[i-1].Text == second[j-1].Text:
+				current[j] = previous[j-1] + 1
+			case previous[j] >= current[j-1]:
+				current[j] = previous[j]
+			default:
+				current[j] = current[j-1]
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(second)]
+}
@@ -0,0 +1,114 @@
+package complexCommons
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// testMethod
+// Makes a method with one token per line, named after its texts and originals
+func testMethod(name string, texts []string, originals []string) MethodTokens {
+	tokens := make([]NormalizedToken, 0, len(texts))
+	for i := range texts {
+		tokens = append(tokens, NormalizedToken{Text: texts[i], Original: originals[i], Line: i + 1})
+	}
+	return MethodTokens{FileName: "shapes.ts", MethodName: name, StartLine: 1, EndLine: len(tokens), Tokens: tokens}
+}
+
+func TestDetectClones(t *testing.T) {
+	texts := numberedTokens("t", 40)
+	names := numberedTokens("n", 40)
+	gappedTexts := append(append([]string{}, texts[:10]...), texts[14:]...)
+	gappedNames := append(append([]string{}, names[:10]...), names[14:]...)
+
+	original := testMethod("area", texts, names)
+	exact := testMethod("areaCopy", texts, names)
+	renamed := testMethod("size", texts, numberedTokens("renamed", 40))
+	gapped := testMethod("shortArea", gappedTexts, gappedNames)
+	short := testMethod("getWidth", texts[:MinimumCloneTokens-1], names[:MinimumCloneTokens-1])
+	shortCopy := testMethod("getHeight", texts[:MinimumCloneTokens-1], names[:MinimumCloneTokens-1])
+	unrelated := testMethod("perimeter", numberedTokens("u", 40), numberedTokens("u", 40))
+
+	tests := []struct {
+		name       string
+		methods    []MethodTokens
+		groups     []string // the type and members of each group
+		similarity float64  // of the first group
+		duplicated int
+		percentage float64
+	}{
+		{
+			name:       "exact copies",
+			methods:    []MethodTokens{original, exact, unrelated},
+			groups:     []string{"1 [area areaCopy]"},
+			similarity: 1,
+			duplicated: 40,
+			percentage: 100.0 / 3,
+		},
+		{
+			name:       "renamed copies",
+			methods:    []MethodTokens{original, renamed},
+			groups:     []string{"2 [area size]"},
+			similarity: 1,
+			duplicated: 40,
+			percentage: 50,
+		},
+		{
+			name:       "copies with statements taken out",
+			methods:    []MethodTokens{original, gapped},
+			groups:     []string{"3 [area shortArea]"},
+			similarity: 72.0 / 76,
+			duplicated: 36, // the larger copy is counted as the original
+			percentage: 100 * 36.0 / 76,
+		},
+		{
+			name:       "a group is the loosest kind of clone in it",
+			methods:    []MethodTokens{original, exact, renamed},
+			groups:     []string{"2 [area areaCopy size]"},
+			similarity: 1,
+			duplicated: 80,
+			percentage: 100 * 80.0 / 120,
+		},
+		{
+			name:       "short methods are never clones",
+			methods:    []MethodTokens{short, shortCopy},
+			groups:     []string{},
+			duplicated: 0,
+			percentage: 0,
+		},
+		{
+			name:       "unrelated methods",
+			methods:    []MethodTokens{original, unrelated},
+			groups:     []string{},
+			duplicated: 0,
+			percentage: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report := DetectClones(test.methods)
+			groups := make([]string, 0, len(report.Groups))
+			for _, group := range report.Groups {
+				members := make([]string, 0, len(group.Members))
+				for _, member := range group.Members {
+					members = append(members, member.MethodName)
+				}
+				groups = append(groups, fmt.Sprintf("%d %v", group.Type, members))
+			}
+			if fmt.Sprint(groups) != fmt.Sprint(test.groups) {
+				t.Fatalf("groups = %v, want %v", groups, test.groups)
+			}
+			if len(report.Groups) > 0 && math.Abs(report.Groups[0].Similarity-test.similarity) > 1e-9 {
+				t.Errorf("similarity = %f, want %f", report.Groups[0].Similarity, test.similarity)
+			}
+			if report.DuplicatedTokens != test.duplicated {
+				t.Errorf("duplicated tokens = %d, want %d", report.DuplicatedTokens, test.duplicated)
+			}
+			if math.Abs(report.DuplicationPercentage-test.percentage) > 1e-9 {
+				t.Errorf("duplication = %.2f%%, want %.2f%%", report.DuplicationPercentage, test.percentage)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package complexCommons
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+)
+
+// Changing what is found in a file makes every cached result wrong, so this is part of every cache key
+// and has to be raised whenever a language parser changes what it gives back
+const complexityCacheVersion = 5
+
+// What a student is graded on comes out of the cache, so it is made for the grader alone
+// and nothing anyone else can write to is read from it
+const (
+	cacheDirectoryPermission   = 0700
+	cacheOthersWritePermission = 0022
+)
+
+// FileParser
+// The complexity parsers that can parse a file once into everything the grader looks at in it
+type FileParser interface {
+	IComplexityParser
+	ParseFile(filename string, includeTokens bool) FileParseResult
+}
+
+// AnalyzedFile
+// Everything found in one file and whether it came from the cache
+type AnalyzedFile struct {
+	Result FileParseResult
+	Cached bool
+}
+
+// CacheStats
+// How many files of an analysis were found in the cache
+type CacheStats struct {
+	Files  int
+	Hits   int
+	Misses int
+	Failed int
+}
+
+// HitRate
+// The part of the files that were read from the cache, from 0 to 1
+func (s CacheStats) HitRate() float64 {
+	if s.Files == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Files)
+}
+
+// ComplexityAnalyzer
+// Runs a file parser over many files at once, with at most workers files being parsed
+// at the same time. Results are cached on disk by the hash of the content of the file,
+// so a file that is the same in every submission (such as the starter code) is only parsed once
+type ComplexityAnalyzer struct {
+	parser         FileParser
+	workers        int
+	cacheDirectory string // empty turns the cache off
+
+	statsLock sync.Mutex
+	stats     CacheStats
+}
+
+// NewComplexityAnalyzer
+// Uses one worker when workers is less than 1.
+// The cache is turned off if its directory cannot be made, or is not the grader's alone
+func NewComplexityAnalyzer(parser FileParser, workers int, cacheDirectory string) *ComplexityAnalyzer {
+	if workers < 1 {
+		workers = 1
+	}
+	if cacheDirectory != "" && prepareCacheDirectory(cacheDirectory) != nil {
+		cacheDirectory = ""
+	}
+	return &ComplexityAnalyzer{
+		parser:         parser,
+		workers:        workers,
+		cacheDirectory: cacheDirectory,
+	}
+}
+
+// GetCacheStats
+// Returns the cache statistics of every analysis this analyzer has run
+func (a *ComplexityAnalyzer) GetCacheStats() CacheStats {
+	a.statsLock.Lock()
+	defer a.statsLock.Unlock()
+	return a.stats
+}
+
+// AnalyzeFiles
+// Parses every file, returning what was found in them in the same order as the files
+func (a *ComplexityAnalyzer) AnalyzeFiles(files []string, includeTokens bool) []AnalyzedFile {
+	results := make([]AnalyzedFile, len(files))
+
+	jobs := make(chan int)
+	var waitGroup sync.WaitGroup
+	for worker := 0; worker < a.workers; worker++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for i := range jobs {
+				results[i] = a.analyzeFile(files[i], includeTokens)
+			}
+		}()
+	}
+	for i := range files {
+		jobs <- i
+	}
+	close(jobs)
+	waitGroup.Wait()
+	return results
+}
+
+// analyzeFile
+// Reads the file once to both hash it and see if it can be read at all.
+// A cached result may come from the same content under another name, so it is given the name of this file
+func (a *ComplexityAnalyzer) analyzeFile(fileName string, includeTokens bool) AnalyzedFile {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		result := NewFileParseResult(fileName)
+		result.Status = ParseStatusUnreadable
+		result.Err = err
+		a.recordStats(func(stats *CacheStats) { stats.Failed++ })
+		return AnalyzedFile{Result: result}
+	}
+
+	cacheLocation := ""
+	if a.cacheDirectory != "" {
+		cacheLocation = filepath.Join(a.cacheDirectory, a.getCacheKey(fileName, content, includeTokens)+".json")
+		if result, ok := readCachedResult(cacheLocation); ok {
+			a.recordStats(func(stats *CacheStats) { stats.Hits++ })
+			return AnalyzedFile{Result: result.WithFileName(fileName), Cached: true}
+		}
+	}
+
+	result := a.parser.ParseFile(fileName, includeTokens)
+	a.recordStats(func(stats *CacheStats) { stats.Misses++ })
+	// The file could still go away between reading and parsing it, and a parse abandoned on a busy machine
+	// may finish on another, neither of which is what its content gives
+	if cacheLocation != "" && result.Status != ParseStatusUnreadable && result.Status != ParseStatusAbandoned {
+		writeCachedResult(cacheLocation, result)
+	}
+	return AnalyzedFile{Result: result}
+}
+
+func (a *ComplexityAnalyzer) recordStats(update func(stats *CacheStats)) {
+	a.statsLock.Lock()
+	defer a.statsLock.Unlock()
+	a.stats.Files++
+	update(&a.stats)
+}
+
+// getCacheKey
+// The same content is parsed differently by another language or when tokens are recorded,
+// and the file extension decides things such as JSX, so all of them are part of the key
+func (a *ComplexityAnalyzer) getCacheKey(fileName string, content []byte, includeTokens bool) string {
+	hasher := sha256.New()
+	hasher.Write(content)
+	hasher.Write([]byte(fmt.Sprintf("\x00%d\x00%s\x00%s\x00%t", complexityCacheVersion, a.parser.GetFileRegex(), filepath.Ext(fileName), includeTokens)))
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// prepareCacheDirectory
+// Makes the directory if it is not there yet. One that someone else owns, or that others can write to,
+// could hold results planted for content anyone can hash, such as the starter code
+func prepareCacheDirectory(directory string) error {
+	err := os.MkdirAll(directory, cacheDirectoryPermission)
+	if err != nil {
+		return err
+	}
+	info, err := os.Lstat(directory)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", directory)
+	}
+	return checkOwnedByGrader(directory, info)
+}
+
+// checkOwnedByGrader
+// Whether the file belongs to the user the grader runs as, and nobody else can write to it
+func checkOwnedByGrader(location string, info os.FileInfo) error {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || int(stat.Uid) != os.Getuid() {
+		return fmt.Errorf("%s is not owned by the grader", location)
+	}
+	if info.Mode().Perm()&cacheOthersWritePermission != 0 {
+		return fmt.Errorf("%s can be written by others, its permissions are %s", location, info.Mode().Perm())
+	}
+	return nil
+}
+
+// readCachedResult
+// A cache file that cannot be read or decoded is treated as missing, and so is one that is not the grader's alone
+func readCachedResult(location string) (FileParseResult, bool) {
+	file, err := os.Open(location)
+	if err != nil {
+		return FileParseResult{}, false
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil || !info.Mode().IsRegular() || checkOwnedByGrader(location, info) != nil {
+		return FileParseResult{}, false
+	}
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return FileParseResult{}, false
+	}
+	var result FileParseResult
+	if json.Unmarshal(content, &result) != nil {
+		return FileParseResult{}, false
+	}
+	return result, true
+}
+
+// writeCachedResult
+// Writes to a temporary file first, so another grader never reads half of a cache file
+func writeCachedResult(location string, result FileParseResult) {
+	content, err := json.Marshal(result)
+	if err != nil {
+		return
+	}
+	temporary, err := os.CreateTemp(filepath.Dir(location), "cache-*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = temporary.Write(content)
+	closeErr := temporary.Close()
+	if err != nil || closeErr != nil {
+		os.Remove(temporary.Name())
+		return
+	}
+	if os.Rename(temporary.Name(), location) != nil {
+		os.Remove(temporary.Name())
+	}
+}
@@ -0,0 +1,108 @@
+package complexCommons
+
+import (
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+	"math"
+)
+
+// ComplexitySummary
+// Totals of every method in a class or a file
+type ComplexitySummary struct {
+	Name                 string
+	MethodCount          int
+	TotalCycCount        int
+	TotalCogCount        int
+	AverageCycCount      float64
+	AverageCogCount      float64
+	MaxNesting           int
+	SLOC                 int // lines holding code, without blank lines and comments
+	HalsteadVolume       float64
+	MaintainabilityIndex float64
+}
+
+// FileComplexityReport
+// Everything found in one file: the summary of the file, one summary per class and the methods themselves
+type FileComplexityReport struct {
+	FileName    string
+	Summary     ComplexitySummary
+	Classes     []ComplexitySummary
+	Methods     []methodInfoType.MethodInfo
+	ParseStatus string // one of the ParseStatus constants, set by the language parser
+	Diagnostics []Diagnostic
+}
+
+// NewFileComplexityReport
+// Rolls the methods of a file up into the summaries of their classes and of the file.
+// Counting lines of code depends on the language, so the SLOC of the file and of each class is given
+func NewFileComplexityReport(fileName string, methods []methodInfoType.MethodInfo, fileSLOC int, classSLOC map[string]int) FileComplexityReport {
+	report := FileComplexityReport{
+		FileName: fileName,
+		Summary:  ComplexitySummary{Name: fileName},
+		Methods:  methods,
+	}
+
+	classIndexes := map[string]int{}
+	for _, method := range methods {
+		addToSummary(&report.Summary, method)
+		if method.Class == "" {
+			continue
+		}
+		index, found := classIndexes[method.Class]
+		if !found {
+			index = len(report.Classes)
+			classIndexes[method.Class] = index
+			report.Classes = append(report.Classes, ComplexitySummary{Name: method.Class})
+		}
+		addToSummary(&report.Classes[index], method)
+	}
+
+	finishSummary(&report.Summary, fileSLOC)
+	for i := range report.Classes {
+		finishSummary(&report.Classes[i], classSLOC[report.Classes[i].Name])
+	}
+	return report
+}
+
+// GetComplexityReport
+// Rolls the methods of the parsed file up into its complexity report
+func (r FileParseResult) GetComplexityReport() FileComplexityReport {
+	report := NewFileComplexityReport(r.FileName, r.Methods, r.SLOC, r.ClassSLOC)
+	report.ParseStatus = r.Status
+	report.Diagnostics = r.Diagnostics
+	return report
+}
+
+func addToSummary(summary *ComplexitySummary, method methodInfoType.MethodInfo) {
+	summary.MethodCount++
+	summary.TotalCycCount += method.CycCount
+	summary.TotalCogCount += method.CogCount
+	summary.HalsteadVolume += method.Halstead.Volume
+	if method.MaxNesting > summary.MaxNesting {
+		summary.MaxNesting = method.MaxNesting
+	}
+}
+
+func finishSummary(summary *ComplexitySummary, sloc int) {
+	summary.SLOC = sloc
+	if summary.MethodCount > 0 {
+		summary.AverageCycCount = float64(summary.TotalCycCount) / float64(summary.MethodCount)
+		summary.AverageCogCount = float64(summary.TotalCogCount) / float64(summary.MethodCount)
+	}
+	summary.MaintainabilityIndex = MaintainabilityIndex(summary.HalsteadVolume, summary.TotalCycCount, sloc)
+}
+
+// MaintainabilityIndex
+// The normalised maintainability index used by Visual Studio, from 0 (hard to maintain) to 100:
+// max(0, (171 - 5.2 * ln(volume) - 0.23 * cyclomatic - 16.2 * ln(SLOC)) * 100 / 171).
+// Code with no lines is given 100 since there is nothing to maintain
+func MaintainabilityIndex(halsteadVolume float64, cycCount int, sloc int) float64 {
+	if sloc <= 0 {
+		return 100
+	}
+	index := 171 - 0.23*float64(cycCount) - 16.2*math.Log(float64(sloc))
+	if halsteadVolume > 0 {
+		index -= 5.2 * math.Log(halsteadVolume)
+	}
+	index = index * 100 / 171
+	return math.Max(0, math.Min(100, index))
+}
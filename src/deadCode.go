@@ -0,0 +1,135 @@
+package complexCommons
+
+import "sort"
+
+// The kinds of declarations checked for being unused
+const (
+	DeclarationFunction = "function"
+	DeclarationMethod   = "method"
+	DeclarationClass    = "class"
+	DeclarationImport   = "import"
+	DeclarationVariable = "variable"
+)
+
+// Declaration
+// A name declared in a file. Names starting with _ are left out by the language parsers,
+// since that is how code says something is unused on purpose
+type Declaration struct {
+	Name     string
+	Kind     string // one of the Declaration constants
+	Class    string // the class of a method
+	Line     int
+	Exported bool
+}
+
+// UnreachableCode
+// The first statement of a block that can never run, because of the statement before it
+type UnreachableCode struct {
+	FileName string `json:"file"`
+	Line     int    `json:"line"`
+	After    string `json:"after"` // return, throw, break or continue
+}
+
+// FileDeclarations
+// What one file declares and what names it uses. Declarations of entry points, such as test
+// files, are never reported as unused, but the names they use still count for the other files
+type FileDeclarations struct {
+	FileName     string
+	EntryPoint   bool
+	Declarations []Declaration
+	Unreachable  []UnreachableCode
+	References   map[string]int // how many times each name appears in the file, declarations included
+}
+
+// UnusedDeclaration
+// A declaration nothing in the submission refers to
+type UnusedDeclaration struct {
+	FileName string `json:"file"`
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Class    string `json:"class,omitempty"`
+	Line     int    `json:"line"`
+}
+
+// DeadCodeReport
+// Everything in a submission that is never used or can never run
+type DeadCodeReport struct {
+	Unused      []UnusedDeclaration `json:"unused"`
+	Unreachable []UnreachableCode   `json:"unreachable"`
+}
+
+// FindDeadCode
+// Exported declarations are never reported, they may be used by whatever imports the submission.
+// Names are matched by text rather than by scope, so a declaration is only reported when its
+// name appears nowhere it could be used from:
+//   - anything is used by its name appearing in its own file outside of a declaration
+//   - methods are also used by their name appearing in any other file outside of a declaration,
+//     since what object a method is called on is not known
+func FindDeadCode(files []FileDeclarations) DeadCodeReport {
+	report := DeadCodeReport{Unused: []UnusedDeclaration{}, Unreachable: []UnreachableCode{}}
+
+	declared := make([]map[string]int, len(files))
+	for i, file := range files {
+		declared[i] = map[string]int{}
+		for _, declaration := range file.Declarations {
+			declared[i][declaration.Name]++
+		}
+	}
+
+	for i, file := range files {
+		for _, unreachable := range file.Unreachable {
+			unreachable.FileName = file.FileName
+			report.Unreachable = append(report.Unreachable, unreachable)
+		}
+		if file.EntryPoint {
+			continue
+		}
+		for _, declaration := range file.Declarations {
+			if declaration.Exported || isDeclarationUsed(files, declared, i, declaration) {
+				continue
+			}
+			report.Unused = append(report.Unused, UnusedDeclaration{
+				FileName: file.FileName,
+				Name:     declaration.Name,
+				Kind:     declaration.Kind,
+				Class:    declaration.Class,
+				Line:     declaration.Line,
+			})
+		}
+	}
+
+	sort.SliceStable(report.Unused, func(i, j int) bool {
+		if report.Unused[i].FileName != report.Unused[j].FileName {
+			return report.Unused[i].FileName < report.Unused[j].FileName
+		}
+		return report.Unused[i].Line < report.Unused[j].Line
+	})
+	sort.SliceStable(report.Unreachable, func(i, j int) bool {
+		if report.Unreachable[i].FileName != report.Unreachable[j].FileName {
+			return report.Unreachable[i].FileName < report.Unreachable[j].FileName
+		}
+		return report.Unreachable[i].Line < report.Unreachable[j].Line
+	})
+	return report
+}
+
+// isDeclarationUsed
+// Checks the file of the declaration, and the other files for a method
+func isDeclarationUsed(files []FileDeclarations, declared []map[string]int, fileIndex int, declaration Declaration) bool {
+	name := declaration.Name
+	if files[fileIndex].References[name] > declared[fileIndex][name] {
+		return true
+	}
+	if declaration.Kind != DeclarationMethod {
+		return false
+	}
+	for i, file := range files {
+		if i == fileIndex {
+			continue
+		}
+		if file.References[name]-declared[i][name] > 0 {
+			return true
+		}
+	}
+	return false
+}
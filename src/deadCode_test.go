@@ -0,0 +1,132 @@
+package complexCommons
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// testDeclarations
+// Makes the declarations of a file, counting every word of the source as a reference to that name
+func testDeclarations(fileName string, source string, declarations ...Declaration) FileDeclarations {
+	file := FileDeclarations{FileName: fileName, Declarations: declarations, References: map[string]int{}}
+	for _, word := range strings.Fields(source) {
+		file.References[word]++
+	}
+	return file
+}
+
+func entryPoint(file FileDeclarations) FileDeclarations {
+	file.EntryPoint = true
+	return file
+}
+
+func TestFindDeadCode(t *testing.T) {
+	function := Declaration{Name: "total", Kind: DeclarationFunction, Line: 1}
+	exported := Declaration{Name: "total", Kind: DeclarationFunction, Line: 1, Exported: true}
+	imported := Declaration{Name: "total", Kind: DeclarationImport, Line: 1}
+	method := Declaration{Name: "push", Kind: DeclarationMethod, Class: "Stack", Line: 3}
+
+	tests := []struct {
+		name   string
+		files  []FileDeclarations
+		unused []string
+	}{
+		{
+			name:   "used in its own file",
+			files:  []FileDeclarations{testDeclarations("a.ts", "total total", function)},
+			unused: []string{},
+		},
+		{
+			name:   "never used",
+			files:  []FileDeclarations{testDeclarations("a.ts", "total", function)},
+			unused: []string{"a.ts:1 function total"},
+		},
+		{
+			name: "exported and imported by another file",
+			files: []FileDeclarations{
+				testDeclarations("a.ts", "total", exported),
+				testDeclarations("b.ts", "total total", imported),
+			},
+			unused: []string{},
+		},
+		{
+			name: "exported but never imported, it may be used from outside",
+			files: []FileDeclarations{
+				testDeclarations("a.ts", "total", exported),
+				testDeclarations("b.ts", "other"),
+			},
+			unused: []string{},
+		},
+		{
+			name: "an unused import does not make what it imports unused",
+			files: []FileDeclarations{
+				testDeclarations("a.ts", "total", exported),
+				testDeclarations("b.ts", "total", imported),
+			},
+			unused: []string{"b.ts:1 import total"},
+		},
+		{
+			name: "a name in another file does not use what is not exported",
+			files: []FileDeclarations{
+				testDeclarations("a.ts", "total", function),
+				testDeclarations("b.ts", "total"),
+			},
+			unused: []string{"a.ts:1 function total"},
+		},
+		{
+			name: "method called from another file",
+			files: []FileDeclarations{
+				testDeclarations("stack.ts", "push", method),
+				testDeclarations("app.ts", "stack push"),
+			},
+			unused: []string{},
+		},
+		{
+			name: "method of the same name declared in another file is not a call",
+			files: []FileDeclarations{
+				testDeclarations("stack.ts", "push", method),
+				testDeclarations("queue.ts", "push", Declaration{Name: "push", Kind: DeclarationMethod, Class: "Queue", Line: 5}),
+			},
+			unused: []string{"queue.ts:5 method push", "stack.ts:3 method push"},
+		},
+		{
+			name: "entry points use names but are never reported",
+			files: []FileDeclarations{
+				testDeclarations("a.ts", "total", exported),
+				entryPoint(testDeclarations("a.test.ts", "total total helper", imported, Declaration{Name: "helper", Kind: DeclarationFunction, Line: 2})),
+			},
+			unused: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report := FindDeadCode(test.files)
+			unused := make([]string, 0, len(report.Unused))
+			for _, declaration := range report.Unused {
+				unused = append(unused, fmt.Sprintf("%s:%d %s %s", declaration.FileName, declaration.Line, declaration.Kind, declaration.Name))
+			}
+			if fmt.Sprint(unused) != fmt.Sprint(test.unused) {
+				t.Errorf("unused = %v, want %v", unused, test.unused)
+			}
+		})
+	}
+}
+
+func TestFindDeadCodeUnreachable(t *testing.T) {
+	first := testDeclarations("b.ts", "")
+	first.Unreachable = []UnreachableCode{{Line: 9, After: "throw"}, {Line: 4, After: "return"}}
+	second := entryPoint(testDeclarations("a.test.ts", ""))
+	second.Unreachable = []UnreachableCode{{Line: 7, After: "break"}}
+
+	report := FindDeadCode([]FileDeclarations{first, second})
+	want := []UnreachableCode{
+		{FileName: "a.test.ts", Line: 7, After: "break"},
+		{FileName: "b.ts", Line: 4, After: "return"},
+		{FileName: "b.ts", Line: 9, After: "throw"},
+	}
+	if fmt.Sprint(report.Unreachable) != fmt.Sprint(want) {
+		t.Errorf("unreachable = %v, want %v", report.Unreachable, want)
+	}
+}
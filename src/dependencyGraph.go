@@ -0,0 +1,261 @@
+package complexCommons
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// The ways a file can depend on a module
+const (
+	ImportKindImport  = "import"  // import x from 'module'
+	ImportKindExport  = "export"  // export * from 'module'
+	ImportKindRequire = "require" // require('module')
+)
+
+// ImportInfo
+// One module a file depends on, as it was written in the file
+type ImportInfo struct {
+	FileName string
+	Module   string
+	Line     int
+	Kind     string
+}
+
+// IsRelative
+// Checks if the module is a path to another file (./x or ../x) rather than a package
+func (i ImportInfo) IsRelative() bool {
+	return i.Module == "." || i.Module == ".." || strings.HasPrefix(i.Module, "./") || strings.HasPrefix(i.Module, "../")
+}
+
+// GetPackageName
+// Gets the package a module comes from, so lodash/fp is lodash and @scope/name/x is @scope/name
+func (i ImportInfo) GetPackageName() string {
+	parts := strings.Split(i.Module, "/")
+	if strings.HasPrefix(i.Module, "@") && len(parts) > 1 {
+		return parts[0] + "/" + parts[1]
+	}
+	return parts[0]
+}
+
+// DependencyEdge
+// A file depending on another file of the submission, or on a package when External is set.
+// A relative import that matches no file of the submission keeps the path it points to
+type DependencyEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Module   string `json:"module"`
+	Line     int    `json:"line"`
+	Kind     string `json:"kind"`
+	External bool   `json:"external"`
+	Missing  bool   `json:"missing"`
+}
+
+// DependencyGraph
+// The modules of a submission and what they import, with every file named relative to the root of the submission
+type DependencyGraph struct {
+	Files    []string         `json:"files"`
+	Packages []string         `json:"packages"`
+	Edges    []DependencyEdge `json:"edges"`
+	Cycles   [][]string       `json:"cycles"`
+}
+
+// The extensions tried, in order, when a relative import leaves them out
+var moduleExtensions = []string{"", ".ts", ".tsx", ".d.ts", ".js", ".jsx", "/index.ts", "/index.tsx", "/index.js", "/index.jsx"}
+
+// BuildDependencyGraph
+// Resolves the imports of every file against the files of the submission and finds the cycles between them.
+// Files and the file names of the imports are expected to be under root
+func BuildDependencyGraph(root string, files []string, imports []ImportInfo) DependencyGraph {
+	graph := DependencyGraph{Files: []string{}, Packages: []string{}, Edges: []DependencyEdge{}}
+	known := map[string]bool{}
+	for _, file := range files {
+		name := getRelativeName(root, file)
+		if !known[name] {
+			known[name] = true
+			graph.Files = append(graph.Files, name)
+		}
+	}
+
+	packages := map[string]bool{}
+	for _, info := range imports {
+		edge := DependencyEdge{
+			From:   getRelativeName(root, info.FileName),
+			Module: info.Module,
+			Line:   info.Line,
+			Kind:   info.Kind,
+		}
+		if info.IsRelative() {
+			edge.To, edge.Missing = resolveRelativeImport(edge.From, info.Module, known)
+		} else {
+			edge.To = info.GetPackageName()
+			edge.External = true
+			packages[edge.To] = true
+		}
+		graph.Edges = append(graph.Edges, edge)
+	}
+	for name := range packages {
+		graph.Packages = append(graph.Packages, name)
+	}
+
+	sort.Strings(graph.Files)
+	sort.Strings(graph.Packages)
+	graph.Cycles = findCycles(graph.Files, graph.Edges)
+	return graph
+}
+
+// ResolveRelativeImport
+// Gets the path, relative to the root of the submission, that a relative import points to
+func ResolveRelativeImport(fromFile string, module string) string {
+	return path.Join(path.Dir(fromFile), module)
+}
+
+func resolveRelativeImport(fromFile string, module string, known map[string]bool) (string, bool) {
+	target := ResolveRelativeImport(fromFile, module)
+	for _, extension := range moduleExtensions {
+		if known[target+extension] {
+			return target + extension, false
+		}
+	}
+	return target, true
+}
+
+func getRelativeName(root string, file string) string {
+	file = path.Clean(strings.ReplaceAll(file, "\\", "/"))
+	root = path.Clean(strings.ReplaceAll(root, "\\", "/"))
+	if root != "." && strings.HasPrefix(file, root+"/") {
+		return file[len(root)+1:]
+	}
+	return file
+}
+
+// findCycles
+// Every strongly connected component with more than one file is a cycle, as is a file that imports itself
+func findCycles(files []string, edges []DependencyEdge) [][]string {
+	adjacent := map[string][]string{}
+	selfImports := map[string]bool{}
+	for _, edge := range edges {
+		if edge.External || edge.Missing {
+			continue
+		}
+		if edge.From == edge.To {
+			selfImports[edge.From] = true
+		}
+		adjacent[edge.From] = append(adjacent[edge.From], edge.To)
+	}
+
+	cycles := [][]string{}
+	for _, component := range findStronglyConnectedComponents(files, adjacent) {
+		if len(component) > 1 || selfImports[component[0]] {
+			cycles = append(cycles, component)
+		}
+	}
+	return cycles
+}
+
+// findStronglyConnectedComponents
+// Tarjan's algorithm. Every node is in exactly one component, each component
+// is sorted and the components are sorted by their first node
+func findStronglyConnectedComponents(nodes []string, adjacent map[string][]string) [][]string {
+	index := 0
+	indexes := map[string]int{}
+	lowLinks := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	components := [][]string{}
+
+	var connect func(node string)
+	connect = func(node string) {
+		indexes[node] = index
+		lowLinks[node] = index
+		index++
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, next := range adjacent[node] {
+			if _, visited := indexes[next]; !visited {
+				connect(next)
+				if lowLinks[next] < lowLinks[node] {
+					lowLinks[node] = lowLinks[next]
+				}
+			} else if onStack[next] && indexes[next] < lowLinks[node] {
+				lowLinks[node] = indexes[next]
+			}
+		}
+
+		if lowLinks[node] != indexes[node] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == node {
+				break
+			}
+		}
+		sort.Strings(component)
+		components = append(components, component)
+	}
+
+	for _, node := range nodes {
+		if _, visited := indexes[node]; !visited {
+			connect(node)
+		}
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i][0] < components[j][0]
+	})
+	return components
+}
+
+// ToJSON
+// Writes the graph as indented JSON
+func (g DependencyGraph) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// ToDOT
+// Writes the graph in the DOT language of Graphviz.
+// Packages are drawn as boxes and the edges of a cycle are drawn in red
+func (g DependencyGraph) ToDOT() string {
+	inCycle := map[string]int{}
+	for i, cycle := range g.Cycles {
+		for _, file := range cycle {
+			inCycle[file] = i + 1
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString("digraph dependencies {\n")
+	for _, file := range g.Files {
+		builder.WriteString(fmt.Sprintf("  %q;\n", file))
+	}
+	for _, name := range g.Packages {
+		builder.WriteString(fmt.Sprintf("  %q [shape=box];\n", name))
+	}
+
+	drawn := map[string]bool{}
+	for _, edge := range g.Edges {
+		key := edge.From + "\x00" + edge.To
+		if drawn[key] {
+			continue
+		}
+		drawn[key] = true
+
+		attributes := ""
+		switch {
+		case edge.Missing:
+			attributes = " [style=dashed]"
+		case !edge.External && inCycle[edge.From] != 0 && inCycle[edge.From] == inCycle[edge.To]:
+			attributes = " [color=red]"
+		}
+		builder.WriteString(fmt.Sprintf("  %q -> %q%s;\n", edge.From, edge.To, attributes))
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
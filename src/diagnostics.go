@@ -0,0 +1,90 @@
+package complexCommons
+
+import methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+
+// How parsing a file went
+const (
+	ParseStatusParsed       = "PARSED"
+	ParseStatusEmpty        = "EMPTY"         // the file has nothing but whitespace
+	ParseStatusUnreadable   = "UNREADABLE"    // the file could not be read
+	ParseStatusSyntaxErrors = "SYNTAX_ERRORS" // the parser recovered, so the methods it found are still given
+	ParseStatusAbandoned    = "ABANDONED"     // parsing took too long or too much memory, nothing was found
+)
+
+// Diagnostic
+// A syntax error found while lexing or parsing. Lines and columns start at 1
+type Diagnostic struct {
+	FileName string
+	Line     int
+	Column   int
+	Message  string
+}
+
+// FileParseResult
+// Everything found in one file from parsing it once, telling a file that could not be parsed
+// apart from one that has no methods. A file that could not be parsed has every part empty
+type FileParseResult struct {
+	FileName    string
+	Status      string
+	Methods     []methodInfoType.MethodInfo
+	Diagnostics []Diagnostic
+	Err         error `json:"-"` // why the file could not be read or parsed
+
+	SLOC         int            // lines holding code, for the complexity report
+	ClassSLOC    map[string]int // lines holding code in each class
+	Classes      []ClassInfo    // for ComputeClassMetrics
+	Imports      []ImportInfo   // for BuildDependencyGraph
+	Calls        FileCalls      // for BuildCallGraph
+	Declarations FileDeclarations
+	MethodTokens []MethodTokens    // for DetectClones
+	Tokens       []NormalizedToken // every token of the file, only when tokens were asked for, for FingerprintFile
+}
+
+// NewFileParseResult
+// A result with nothing found in it yet
+func NewFileParseResult(fileName string) FileParseResult {
+	return FileParseResult{
+		FileName:     fileName,
+		Methods:      []methodInfoType.MethodInfo{},
+		Diagnostics:  []Diagnostic{},
+		ClassSLOC:    map[string]int{},
+		Classes:      []ClassInfo{},
+		Imports:      []ImportInfo{},
+		Calls:        FileCalls{FileName: fileName, Extends: map[string]string{}},
+		Declarations: FileDeclarations{FileName: fileName, References: map[string]int{}},
+		MethodTokens: []MethodTokens{},
+		Tokens:       []NormalizedToken{},
+	}
+}
+
+// Failed
+// Checks if the file could not be read, had syntax errors or could not be parsed in time
+func (r FileParseResult) Failed() bool {
+	return r.Status == ParseStatusUnreadable || r.Status == ParseStatusSyntaxErrors || r.Status == ParseStatusAbandoned
+}
+
+// WithFileName
+// Gives the file and everything found in it another name, such as its path relative to the submission
+func (r FileParseResult) WithFileName(fileName string) FileParseResult {
+	r.FileName = fileName
+	r.Diagnostics = append([]Diagnostic{}, r.Diagnostics...)
+	for i := range r.Diagnostics {
+		r.Diagnostics[i].FileName = fileName
+	}
+	r.Classes = append([]ClassInfo{}, r.Classes...)
+	for i := range r.Classes {
+		r.Classes[i].FileName = fileName
+	}
+	r.Imports = append([]ImportInfo{}, r.Imports...)
+	for i := range r.Imports {
+		r.Imports[i].FileName = fileName
+	}
+	r.Calls.FileName = fileName
+	r.Calls.Imports = r.Imports
+	r.Declarations.FileName = fileName
+	r.MethodTokens = append([]MethodTokens{}, r.MethodTokens...)
+	for i := range r.MethodTokens {
+		r.MethodTokens[i].FileName = fileName
+	}
+	return r
+}
@@ -0,0 +1,30 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/complexity/complexCommons"
+	"errors"
+)
+
+// GetGrader
+// Creates the grader of the language an assignment is written in
+func GetGrader(language string) (IGrader, error) {
+	switch language {
+	case "typescript":
+		return newTypescriptGrader(), nil
+	case "r":
+		return newRGrader(), nil
+	}
+	return nil, errors.New("no grader for language: " + language)
+}
+
+// DetectPlagiarism
+// Compares the submissions of an assignment written in the language, the name of each submission
+// mapped to where it is, with each other and with the archive of past semesters.
+// A grader only ever sees one submission, so this is run once for the whole assignment after grading
+func DetectPlagiarism(language string, submissions map[string]string, options PlagiarismOptions) ([]complexCommons.SimilarityPair, error) {
+	switch language {
+	case "typescript":
+		return DetectTypescriptPlagiarism(submissions, options)
+	}
+	return nil, errors.New("no plagiarism detection for language: " + language)
+}
@@ -0,0 +1,135 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/complexity/complexCommons"
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetGrader(t *testing.T) {
+	tests := []struct {
+		language string
+		want     string
+	}{
+		{language: "typescript", want: "*graderFactory.typescriptGrader"},
+		{language: "r", want: "*graderFactory.rGrader"},
+	}
+	for _, test := range tests {
+		t.Run(test.language, func(t *testing.T) {
+			grader, err := GetGrader(test.language)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := fmt.Sprintf("%T", grader); got != test.want {
+				t.Errorf("GetGrader(%q) = %s, want %s", test.language, got, test.want)
+			}
+		})
+	}
+
+	if _, err := GetGrader("cobol"); err == nil {
+		t.Error("expected an error for a language without a grader")
+	}
+}
+
+func TestDetectPlagiarism(t *testing.T) {
+	template := `export function readNumbers(text: string): number[] {
+  return text.split(',').map(part => Number(part.trim())).filter(value => !isNaN(value));
+}
+`
+	copied := `export class Stack {
+  private items: number[] = [];
+
+  push(item: number): void {
+    if (item < 0) {
+      throw new Error('negative items are not allowed');
+    }
+    this.items.push(item);
+  }
+
+  pop(): number | undefined {
+    return this.items.length > 0 ? this.items.pop() : undefined;
+  }
+}
+`
+	// Renaming everything does not hide a copy
+	renamed := `export class Pile {
+  private values: number[] = [];
+
+  push(value: number): void {
+    if (value < 0) {
+      throw new Error('no negative values');
+    }
+    this.values.push(value);
+  }
+
+  pop(): number | undefined {
+    return this.values.length > 0 ? this.values.pop() : undefined;
+  }
+}
+`
+	own := `export function sum(values: number[]): number {
+  let total = 0;
+  for (const value of values) {
+    total += value;
+  }
+  return total;
+}
+`
+	cache := t.TempDir()
+	t.Setenv("COMPLEXITY_CACHE_DIRECTORY", cache)
+	templateDirectory := writeSubmission(t, map[string]string{"src/input.ts": template})
+	submissions := map[string]string{
+		"alice": writeSubmission(t, map[string]string{"src/input.ts": template, "src/stack.ts": copied}),
+		"bob":   writeSubmission(t, map[string]string{"src/input.ts": template, "src/stack.ts": renamed}),
+		"carol": writeSubmission(t, map[string]string{"src/input.ts": template, "src/sum.ts": own}),
+	}
+	pairNames := func(pairs []complexCommons.SimilarityPair) []string {
+		names := []string{}
+		for _, pair := range pairs {
+			names = append(names, pair.FirstSemester+"/"+pair.First+" "+pair.SecondSemester+"/"+pair.Second)
+		}
+		return names
+	}
+
+	if _, err := DetectPlagiarism("cobol", submissions, PlagiarismOptions{MinimumShared: 1}); err == nil {
+		t.Error("expected an error for a language without plagiarism detection")
+	}
+
+	pairs, err := DetectPlagiarism("typescript", submissions, PlagiarismOptions{MinimumShared: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(pairNames(pairs)); got != "[/alice /bob /alice /carol /bob /carol]" {
+		t.Errorf("without the template every submission shares it, got %s", got)
+	}
+	// The template shared by every submission is only parsed once, the same as in grading
+	if entries, _ := filepath.Glob(filepath.Join(cache, "*.json")); len(entries) != 4 {
+		t.Errorf("cache entries = %v, want one for each different file", entries)
+	}
+
+	archive := filepath.Join(t.TempDir(), "archive.json")
+	options := PlagiarismOptions{Semester: "fall", TemplateDirectory: templateDirectory, ArchiveLocation: archive, MinimumShared: 1, AddToArchive: true}
+	pairs, err = DetectPlagiarism("typescript", submissions, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(pairNames(pairs)); got != "[fall/alice fall/bob]" {
+		t.Fatalf("pairs = %s, want only alice and bob", got)
+	}
+	if pairs[0].GetSimilarity() != 1 || len(pairs[0].Regions) == 0 || pairs[0].Regions[0].FirstFile != "src/stack.ts" {
+		t.Errorf("alice and bob = %.2f in %+v, want all of src/stack.ts", pairs[0].GetSimilarity(), pairs[0].Regions)
+	}
+
+	// The next semester is compared with the archive
+	options.Semester = "spring"
+	options.AddToArchive = false
+	later := map[string]string{"dave": writeSubmission(t, map[string]string{"src/stack.ts": copied})}
+	pairs, err = DetectPlagiarism("typescript", later, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(pairNames(pairs)); got != "[spring/dave fall/alice spring/dave fall/bob]" {
+		t.Errorf("pairs = %s, want dave with alice and bob of the semester before", got)
+	}
+}
@@ -0,0 +1,197 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/common"
+	"SubmissionGrader/internal/complexity/complexCommons"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	dependencyGraphJSON = "/dependency-graph.json"
+	dependencyGraphDOT  = "/dependency-graph.dot"
+)
+
+// ImportPolicy
+// What an assignment lets a submission import, kept in the rubric:
+//
+//	"importPolicy": {
+//	  "banned": ["lodash", "underscore"],
+//	  "relativeImportRoot": "src",
+//	  "bannedPaths": ["src/test"],
+//	  "deduction": 5,
+//	  "maxDeduction": 20
+//	}
+//
+// Packages are matched by name, so banning lodash also bans lodash/fp.
+// When Allowed is not empty, it is the only packages that can be imported
+type ImportPolicy struct {
+	Allowed            []string `json:"allowed"`
+	Banned             []string `json:"banned"`
+	RelativeImportRoot string   `json:"relativeImportRoot"` // relative imports cannot reach outside of this directory, empty allows anything
+	BannedPaths        []string `json:"bannedPaths"`        // files and directories that relative imports cannot reach
+	AllowCycles        bool     `json:"allowCycles"`
+	Deduction          float64  `json:"deduction"`    // taken off for every violation
+	MaxDeduction       float64  `json:"maxDeduction"` // 0 means no cap
+}
+
+// The rules of the import policy
+const (
+	importRuleBanned      = "banned"
+	importRuleNotAllowed  = "notAllowed"
+	importRuleOutsideRoot = "outsideRoot"
+	importRuleBannedPath  = "bannedPath"
+	importRuleCycle       = "cycle"
+)
+
+type ImportViolation struct {
+	File    string
+	Line    int
+	Module  string
+	Rule    string
+	Message string
+}
+
+// Check
+// Goes through every edge of the graph looking for imports the policy does not let through.
+// Relative imports that match no file are left alone, as they can point at files that are not code (./data.json)
+func (p ImportPolicy) Check(graph complexCommons.DependencyGraph) []ImportViolation {
+	var violations []ImportViolation
+	for _, edge := range graph.Edges {
+		violation := ImportViolation{File: edge.From, Line: edge.Line, Module: edge.Module}
+		if edge.External {
+			switch {
+			case matchesPackage(edge.To, p.Banned):
+				violation.Rule = importRuleBanned
+				violation.Message = fmt.Sprintf("%s is banned in this assignment", edge.To)
+			case len(p.Allowed) > 0 && !matchesPackage(edge.To, p.Allowed):
+				violation.Rule = importRuleNotAllowed
+				violation.Message = fmt.Sprintf("%s is not one of the packages allowed in this assignment", edge.To)
+			default:
+				continue
+			}
+			violations = append(violations, violation)
+			continue
+		}
+
+		switch {
+		case p.RelativeImportRoot != "" && !isInsidePath(edge.To, p.RelativeImportRoot):
+			violation.Rule = importRuleOutsideRoot
+			violation.Message = fmt.Sprintf("%s reaches outside of %s", edge.Module, p.RelativeImportRoot)
+		case matchesAnyPath(edge.To, p.BannedPaths):
+			violation.Rule = importRuleBannedPath
+			violation.Message = fmt.Sprintf("%s cannot be imported in this assignment", edge.To)
+		default:
+			continue
+		}
+		violations = append(violations, violation)
+	}
+
+	if !p.AllowCycles {
+		for _, cycle := range graph.Cycles {
+			violations = append(violations, ImportViolation{
+				File:    cycle[0],
+				Rule:    importRuleCycle,
+				Message: fmt.Sprintf("Files import each other in a cycle: %s", strings.Join(cycle, ", ")),
+			})
+		}
+	}
+	return violations
+}
+
+func matchesPackage(name string, packages []string) bool {
+	for _, entry := range packages {
+		if name == entry || strings.HasPrefix(name, entry+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func isInsidePath(file string, directory string) bool {
+	directory = filepath.ToSlash(filepath.Clean(directory))
+	return directory == "." || file == directory || strings.HasPrefix(file, directory+"/")
+}
+
+func matchesAnyPath(file string, paths []string) bool {
+	for _, path := range paths {
+		if isInsidePath(file, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildTypescriptDependencyGraph
+// Gathers the imports of every parsed file into the dependency graph of the submission
+func buildTypescriptDependencyGraph(files []complexCommons.FileParseResult) complexCommons.DependencyGraph {
+	var names []string
+	var imports []complexCommons.ImportInfo
+	for _, file := range files {
+		names = append(names, file.FileName)
+		imports = append(imports, file.Imports...)
+	}
+	return complexCommons.BuildDependencyGraph(".", names, imports)
+}
+
+// Directories that hold code the student did not write, such as what tsc or a bundler compiled
+var ignoredSourceDirectories = map[string]bool{"node_modules": true, "coverage": true, "build": true, "dist": true}
+
+// getTypescriptSourceFiles
+// Finds every TypeScript and JavaScript file under root, leaving out node_modules,
+// coverage reports, compiled output and the teacher tests
+func getTypescriptSourceFiles(root string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if ignoredSourceDirectories[entry.Name()] || path == filepath.Join(root, "src/test/typescript/teacher") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if isTypescriptSource(entry.Name()) {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func isTypescriptSource(name string) bool {
+	for _, extension := range []string{".ts", ".tsx", ".js", ".jsx", ".mjs", ".cjs"} {
+		if strings.HasSuffix(name, extension) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeDependencyGraph
+// Writes the graph as both JSON and DOT next to the submission
+func writeDependencyGraph(directory string, graph complexCommons.DependencyGraph) error {
+	content, err := graph.ToJSON()
+	if err != nil {
+		return err
+	}
+	const permission = 0777
+	err = os.WriteFile(directory+dependencyGraphJSON, content, permission)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(directory+dependencyGraphDOT, []byte(graph.ToDOT()), permission)
+}
+
+// logImportViolations
+// Reports every violation of the import policy, returning true if there were any
+func logImportViolations(violations []ImportViolation) bool {
+	for _, violation := range violations {
+		common.Warning(fmt.Sprintf("Import policy violation (%s) in %s:%d: %s", violation.Rule, violation.File, violation.Line, violation.Message))
+	}
+	return len(violations) > 0
+}
@@ -0,0 +1,62 @@
+package graderFactory
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestGetTypescriptSourceFiles(t *testing.T) {
+	root := writeSubmission(t, map[string]string{
+		"src/stack.ts":                     "export class Stack {}",
+		"src/view.tsx":                     "export const View = () => null;",
+		"src/test/typescript/student/a.ts": "test('a', () => {});",
+		"src/test/typescript/teacher/b.ts": "test('b', () => {});",
+		"node_modules/lodash/index.js":     "module.exports = {};",
+		"coverage/lcov-report/prettify.js": "var a = 1;",
+		"build/stack.js":                   "var Stack = {};",
+		"dist/stack.js":                    "var Stack = {};",
+		"src/dist.ts":                      "export const dist = 1;",
+		"README.md":                        "# Stack",
+	})
+
+	paths, err := getTypescriptSourceFiles(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"src/dist.ts", "src/stack.ts", "src/test/typescript/student/a.ts", "src/view.tsx"}
+	if len(paths) != len(want) {
+		t.Fatalf("files = %v, want %v", paths, want)
+	}
+	for i, path := range paths {
+		relative, err := filepath.Rel(root, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if filepath.ToSlash(relative) != want[i] {
+			t.Errorf("file %d = %s, want %s", i, relative, want[i])
+		}
+	}
+}
+
+func TestBuildTypescriptDependencyGraph(t *testing.T) {
+	root := writeSubmission(t, map[string]string{
+		"src/stack.ts":  "import { Queue } from './queue';\nimport _ from 'lodash/fp';\nexport class Stack {}\n",
+		"src/queue.ts":  "import { Stack } from './stack';\nexport class Queue {}\n",
+		"dist/stack.js": "const _ = require('underscore');\n",
+	})
+
+	files, err := ParseTypescriptSubmission(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	graph := buildTypescriptDependencyGraph(files)
+	if len(graph.Files) != 2 {
+		t.Errorf("files = %v, want src/queue.ts and src/stack.ts", graph.Files)
+	}
+	if len(graph.Packages) != 1 || graph.Packages[0] != "lodash" {
+		t.Errorf("packages = %v, want lodash", graph.Packages)
+	}
+	if len(graph.Cycles) != 1 {
+		t.Errorf("cycles = %v, want the cycle of src/queue.ts and src/stack.ts", graph.Cycles)
+	}
+}
@@ -0,0 +1,168 @@
+package methodInfo
+
+import "math"
+
+// MethodInfo
+// Everything the complexity parsers find out about one method
+type MethodInfo struct {
+	Location           string
+	Class              string
+	MethodName         string
+	Parameter          string
+	Parameters         []ParameterInfo
+	StartLine          int
+	EndLine            int
+	TotalLine          int
+	LinesOfCodeCreated int
+	CogCount           int
+	CycCount           int
+	MaxNesting         int // the deepest nesting of control flow in the method
+	Halstead           HalsteadMetrics
+	Async              AsyncInfo
+	Tokens             []TokenInfo
+}
+
+// TokenInfo
+// One token of a method, only kept when the parser is asked to include tokens
+type TokenInfo struct {
+	Line         int
+	Column       int
+	SymbolicName string
+	RuleName     string
+	Text         string
+}
+
+// AddTokenToMethod
+// Adds the token to the end of the tokens of the method
+func (m *MethodInfo) AddTokenToMethod(line int, column int, symbolicName string, ruleName string, text string) {
+	m.Tokens = append(m.Tokens, TokenInfo{
+		Line:         line,
+		Column:       column,
+		SymbolicName: symbolicName,
+		RuleName:     ruleName,
+		Text:         text,
+	})
+}
+
+// MethodStack
+// Collects the methods of a file as they are finished
+type MethodStack struct {
+	methods []MethodInfo
+}
+
+func NewMethodStack() *MethodStack {
+	return &MethodStack{}
+}
+
+func (s *MethodStack) Push(method MethodInfo) {
+	s.methods = append(s.methods, method)
+}
+
+// ConvertToArray
+// Gets the methods in the order they were finished
+func (s *MethodStack) ConvertToArray() []MethodInfo {
+	return s.methods
+}
+
+// ParameterInfo
+// One parameter of a method, as it was declared.
+// Destructured parameters ({ a, b }: Props) use the whole pattern as their name
+type ParameterInfo struct {
+	Name          string
+	Type          string // empty when no type was declared
+	Optional      bool   // declared with ? or given a default value
+	Rest          bool   // ...args
+	DefaultValue  string
+	Accessibility string // public, private or protected for constructor parameter properties
+}
+
+// IsTyped
+// Checks if the parameter was declared with a type
+func (p ParameterInfo) IsTyped() bool {
+	return p.Type != ""
+}
+
+// HalsteadMetrics
+// Size-aware metrics of a method worked out from the operators and operands in its tokens.
+// Each language decides what is an operator and what is an operand, this only does the math
+type HalsteadMetrics struct {
+	DistinctOperators int // n1
+	DistinctOperands  int // n2
+	TotalOperators    int // N1
+	TotalOperands     int // N2
+
+	Vocabulary int // n = n1 + n2
+	Length     int // N = N1 + N2
+
+	Volume        float64 // V = N * log2(n)
+	Difficulty    float64 // D = (n1 / 2) * (N2 / n2)
+	Effort        float64 // E = D * V
+	EstimatedBugs float64 // B = V / 3000
+}
+
+// NewHalsteadMetrics
+// Takes how many times each operator and operand was used in the method
+func NewHalsteadMetrics(operators map[string]int, operands map[string]int) HalsteadMetrics {
+	metrics := HalsteadMetrics{
+		DistinctOperators: len(operators),
+		DistinctOperands:  len(operands),
+	}
+	for _, count := range operators {
+		metrics.TotalOperators += count
+	}
+	for _, count := range operands {
+		metrics.TotalOperands += count
+	}
+
+	metrics.Vocabulary = metrics.DistinctOperators + metrics.DistinctOperands
+	metrics.Length = metrics.TotalOperators + metrics.TotalOperands
+
+	if metrics.Vocabulary > 0 {
+		metrics.Volume = float64(metrics.Length) * math.Log2(float64(metrics.Vocabulary))
+	}
+	if metrics.DistinctOperands > 0 {
+		metrics.Difficulty = float64(metrics.DistinctOperators) / 2 * float64(metrics.TotalOperands) / float64(metrics.DistinctOperands)
+	}
+	metrics.Effort = metrics.Difficulty * metrics.Volume
+	metrics.EstimatedBugs = metrics.Volume / 3000
+
+	return metrics
+}
+
+// The kinds of problems found in asynchronous code
+const (
+	AsyncIssueAwaitInLoop  = "awaitInLoop"  // each pass waits for the last, Promise.all is usually meant
+	AsyncIssueMissingCatch = "missingCatch" // a .then chain that is thrown away without a rejection handler
+	AsyncIssueNotAwaited   = "notAwaited"   // an async function called as a statement without await or void
+)
+
+// AsyncIssue
+// One problem found in the asynchronous code of a method
+type AsyncIssue struct {
+	Line    int
+	Kind    string // one of the AsyncIssue constants
+	Message string
+}
+
+// AsyncInfo
+// How a method uses async, promises and callbacks.
+// A callback is a function passed straight to a call, such as items.map(x => x * 2),
+// and a callback passed in another callback is nested one level deeper
+type AsyncInfo struct {
+	IsAsync             bool
+	AwaitCount          int
+	LongestPromiseChain int // the most .then, .catch and .finally calls in one chain
+	MaxCallbackNesting  int // the deepest callback in the method (itself included), 0 when there are none
+	Issues              []AsyncIssue
+}
+
+// HasUnhandledPromises
+// Checks if any promise of the method can be rejected without anything seeing it
+func (a AsyncInfo) HasUnhandledPromises() bool {
+	for _, issue := range a.Issues {
+		if issue.Kind == AsyncIssueMissingCatch || issue.Kind == AsyncIssueNotAwaited {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package methodInfo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewHalsteadMetrics(t *testing.T) {
+	tests := []struct {
+		name      string
+		operators map[string]int
+		operands  map[string]int
+		want      HalsteadMetrics
+	}{
+		{
+			// return a + b; has the operators return + ; and the operands a b
+			name:      "return a + b",
+			operators: map[string]int{"return": 1, "+": 1, ";": 1},
+			operands:  map[string]int{"a": 1, "b": 1},
+			want: HalsteadMetrics{
+				DistinctOperators: 3, DistinctOperands: 2, TotalOperators: 3, TotalOperands: 2,
+				Vocabulary: 5, Length: 5,
+				Volume:        5 * math.Log2(5),
+				Difficulty:    1.5,
+				Effort:        1.5 * 5 * math.Log2(5),
+				EstimatedBugs: 5 * math.Log2(5) / 3000,
+			},
+		},
+		{
+			// x = x * x; x = x * y; has = * ; twice each and x five times
+			name:      "operands used more than once",
+			operators: map[string]int{"=": 2, "*": 2, ";": 2},
+			operands:  map[string]int{"x": 5, "y": 1},
+			want: HalsteadMetrics{
+				DistinctOperators: 3, DistinctOperands: 2, TotalOperators: 6, TotalOperands: 6,
+				Vocabulary: 5, Length: 12,
+				Volume:        12 * math.Log2(5),
+				Difficulty:    4.5,
+				Effort:        4.5 * 12 * math.Log2(5),
+				EstimatedBugs: 12 * math.Log2(5) / 3000,
+			},
+		},
+		{
+			name:      "nothing counted",
+			operators: map[string]int{},
+			operands:  map[string]int{},
+			want:      HalsteadMetrics{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := NewHalsteadMetrics(test.operators, test.operands)
+			const tolerance = 1e-9
+			if got.DistinctOperators != test.want.DistinctOperators || got.DistinctOperands != test.want.DistinctOperands ||
+				got.TotalOperators != test.want.TotalOperators || got.TotalOperands != test.want.TotalOperands ||
+				got.Vocabulary != test.want.Vocabulary || got.Length != test.want.Length ||
+				math.Abs(got.Volume-test.want.Volume) > tolerance || math.Abs(got.Difficulty-test.want.Difficulty) > tolerance ||
+				math.Abs(got.Effort-test.want.Effort) > tolerance || math.Abs(got.EstimatedBugs-test.want.EstimatedBugs) > tolerance {
+				t.Errorf("NewHalsteadMetrics() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParameterIsTyped(t *testing.T) {
+	if !(ParameterInfo{Name: "a", Type: "number"}).IsTyped() {
+		t.Error("a: number is not typed")
+	}
+	if (ParameterInfo{Name: "b", Optional: true, DefaultValue: "1"}).IsTyped() {
+		t.Error("b = 1 is typed")
+	}
+}
@@ -0,0 +1,154 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/common"
+	"SubmissionGrader/internal/complexity/complexCommons"
+	"SubmissionGrader/internal/complexity/typescript"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sort"
+)
+
+// PlagiarismOptions
+// How the submissions of an assignment are compared
+type PlagiarismOptions struct {
+	Semester          string // the semester the submissions are kept under in the archive
+	TemplateDirectory string // the starter template, empty when there is none
+	ArchiveLocation   string // JSON file of past semesters, empty to compare only the given submissions
+	MinimumShared     int    // pairs sharing fewer fingerprints are not reported
+	AddToArchive      bool   // keep the submissions in the archive for the semesters after
+}
+
+// DetectTypescriptPlagiarism
+// Fingerprints every submission (the name of the submission mapped to where it is),
+// leaving out code from the starter template, and compares them with each other and
+// with the archive. Returns the pairs ranked from most to least similar.
+// Files are parsed through the complexity analyzer, so each is bounded like in grading and the
+// starter code every submission shares comes out of the cache
+func DetectTypescriptPlagiarism(submissions map[string]string, options PlagiarismOptions) ([]complexCommons.SimilarityPair, error) {
+	parser, ok := typescript.CreateTypescriptComplexityParser().(complexCommons.FileParser)
+	if !ok {
+		return nil, fmt.Errorf("typescript parser cannot parse whole files")
+	}
+	analyzer := complexCommons.NewComplexityAnalyzer(parser, runtime.NumCPU(), getComplexityCacheDirectory())
+
+	template := map[uint64]bool{}
+	if options.TemplateDirectory != "" {
+		files, err := tokenizeTypescriptFiles(analyzer, options.TemplateDirectory)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			for hash := range complexCommons.GetTemplateHashes(file.Tokens) {
+				template[hash] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(submissions))
+	for name := range submissions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	current := make([]complexCommons.SubmissionFingerprints, 0, len(names))
+	for _, name := range names {
+		submission, err := fingerprintTypescriptSubmission(analyzer, name, submissions[name], template)
+		if err != nil {
+			return nil, err
+		}
+		submission.Semester = options.Semester
+		current = append(current, submission)
+	}
+
+	archive := []complexCommons.SubmissionFingerprints{}
+	if options.ArchiveLocation != "" {
+		var err error
+		archive, err = complexCommons.LoadFingerprintArchive(options.ArchiveLocation)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// A submission graded again this semester is compared with the others, not with its own old copy
+	pastSemesters := removeArchivedSubmissions(archive, current)
+
+	pairs := complexCommons.CompareSubmissions(current, pastSemesters, options.MinimumShared)
+	common.Debug(fmt.Sprintf("Compared %d submissions with each other and %d archived submissions, %d pairs share code", len(current), len(pastSemesters), len(pairs)))
+	stats := analyzer.GetCacheStats()
+	common.Debug(fmt.Sprintf("Tokenized %d files, %d found in the cache", stats.Files, stats.Hits))
+
+	if options.AddToArchive && options.ArchiveLocation != "" {
+		err := complexCommons.SaveFingerprintArchive(options.ArchiveLocation, append(pastSemesters, current...))
+		if err != nil {
+			return pairs, err
+		}
+	}
+	return pairs, nil
+}
+
+// fingerprintTypescriptSubmission
+// File names are kept relative to the submission so the regions of a pair can be read side by side
+func fingerprintTypescriptSubmission(analyzer *complexCommons.ComplexityAnalyzer, name string, root string, template map[uint64]bool) (complexCommons.SubmissionFingerprints, error) {
+	submission := complexCommons.SubmissionFingerprints{Name: name}
+	files, err := tokenizeTypescriptFiles(analyzer, root)
+	if err != nil {
+		return submission, err
+	}
+	for _, file := range files {
+		fingerprints := complexCommons.FingerprintFile(file.FileName, file.Tokens)
+		submission.Fingerprints = append(submission.Fingerprints, complexCommons.RemoveTemplateFingerprints(fingerprints, template)...)
+	}
+	return submission, nil
+}
+
+// tokenizeTypescriptFiles
+// Parses every file under root with its tokens, naming each file by its path relative to root.
+// A file that could not be read or was given up on has no tokens, so it is left out of the comparison
+func tokenizeTypescriptFiles(analyzer *complexCommons.ComplexityAnalyzer, root string) ([]complexCommons.FileParseResult, error) {
+	paths, err := getTypescriptSourceFiles(root)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]complexCommons.FileParseResult, 0, len(paths))
+	for i, file := range analyzer.AnalyzeFiles(paths, true) {
+		relative, err := filepath.Rel(root, paths[i])
+		if err != nil {
+			return nil, err
+		}
+		result := file.Result
+		if result.Status == complexCommons.ParseStatusUnreadable || result.Status == complexCommons.ParseStatusAbandoned {
+			common.Warning(fmt.Sprintf("Leaving %s out of the plagiarism check, parsing it gave %s: %s", paths[i], result.Status, result.Err))
+		}
+		files = append(files, result.WithFileName(filepath.ToSlash(relative)))
+	}
+	return files, nil
+}
+
+func removeArchivedSubmissions(archive []complexCommons.SubmissionFingerprints, current []complexCommons.SubmissionFingerprints) []complexCommons.SubmissionFingerprints {
+	currentNames := map[string]bool{}
+	for _, submission := range current {
+		currentNames[submission.Semester+"/"+submission.Name] = true
+	}
+	kept := []complexCommons.SubmissionFingerprints{}
+	for _, submission := range archive {
+		if !currentNames[submission.Semester+"/"+submission.Name] {
+			kept = append(kept, submission)
+		}
+	}
+	return kept
+}
+
+// DetectTypescriptClones
+// Looks for methods copied within the parsed files of one submission, for the complexity report and ApplyDuplicationLimit.
+// Tests are left out, as they are expected to repeat themselves
+func DetectTypescriptClones(files []complexCommons.FileParseResult) complexCommons.CloneReport {
+	var methods []complexCommons.MethodTokens
+	for _, file := range files {
+		if isTestFile(file.FileName) {
+			continue
+		}
+		methods = append(methods, file.MethodTokens...)
+	}
+	return complexCommons.DetectClones(methods)
+}
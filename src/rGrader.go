@@ -0,0 +1,330 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/common"
+	parserFactory "SubmissionGrader/internal/parser"
+	"SubmissionGrader/internal/parser/parserTypes"
+	"SubmissionGrader/internal/parser/parserTypes/list"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	rSourcePath     = "src/main/r"
+	rTestPath       = "src/test/r"
+	rTimeoutReport  = "/TEST-testthat-timeout.xml"
+	rCoverageReport = "/coverage.xml"
+)
+
+type rGrader struct {
+	grader graderStruct
+
+	rubric             *Rubric // loaded from a checkout of the teacher tests, before either phase runs
+	rubricLoaded       bool
+	TeacherRubricScore RubricScore
+	StudentRubricScore RubricScore
+
+	sandbox           *sandboxRunner
+	SandboxViolations []SandboxResult
+}
+
+func (r rGrader) GetGrader() graderStruct {
+	return r.grader
+}
+
+// GetRubricScores
+// Returns the rubric scores of the teacher tests and the student tests.
+// There is no parser for the methods of R, so there are no complexity or duplication deductions to take off
+func (r rGrader) GetRubricScores() (RubricScore, RubricScore) {
+	return r.TeacherRubricScore, r.StudentRubricScore
+}
+
+// GetSandboxViolations
+// Returns every command that was stopped for breaking a sandbox rule
+func (r rGrader) GetSandboxViolations() []SandboxResult {
+	return r.SandboxViolations
+}
+
+// loadRubric
+// Loads the rubric kept with the teacher tests, unless it already was.
+// This happens before the student phase, so both phases are scored with the same rubric
+func (r *rGrader) loadRubric() {
+	if r.rubricLoaded {
+		return
+	}
+	r.rubricLoaded = true
+	rubric, err := loadTeacherRubric(r.grader.GetLocation()+"/"+rTestPath+"/teacher", func(directory string) error {
+		return r.grader.GetTemplateSubDirectory(r.grader, directory, rTestPath+"/teacher", rubricPlacementName)
+	})
+	if err != nil {
+		common.Error(fmt.Sprintf("Failed to load rubric: %s", err))
+		return
+	}
+	r.rubric = rubric
+}
+
+func (r *rGrader) GradeAssignment(grader graderStruct) error {
+	r.loadRubric()
+
+	common.Info(fmt.Sprintf("Grading students test cases"))
+
+	if grader.data.studentTestsEnabled == "true" {
+		r.grader.data.GradingStudentTestCurrently = true
+
+		pathToPotentialTeacherTests := grader.GetLocation() + "/" + rTestPath + "/teacher"
+		if common.CheckIfDirExist(pathToPotentialTeacherTests) {
+			common.Info("Teacher test folder before student test did exist and is being deleted")
+			common.RemoveDir(pathToPotentialTeacherTests)
+		} else {
+			common.Info("Teacher test folder before student test did not exist and is moving on")
+		}
+
+		err := r.gradeSteps()
+		if err != nil {
+			common.Error(fmt.Sprintf("Error in running student tests: %s", err.Error()))
+		}
+		r.grader.data.GradingStudentTestCurrently = false
+	}
+
+	var err error
+
+	if grader.data.teacherUnitTestsEnabled == "true" {
+		if grader.data.studentTestsEnabled == "true" {
+			common.Debug(fmt.Sprintf("Removing Results from student tests"))
+			common.RemoveDir(grader.GetLocation() + grader.data.submissionTestPath)
+		}
+
+		common.RemoveEverythingInThisDirectory(grader.GetLocation()+"/"+rTestPath, "") //REMOVES STUDENT TEST FILES
+
+		subdirectoryToGet := rTestPath + "/teacher"
+		subdirectoryPlacementName := "teacher"
+		if grader.data.UseOriginalStudentTestsInsteadOfTeacherTests == "true" {
+			subdirectoryToGet = rTestPath + "/student"
+			subdirectoryPlacementName = "student"
+		}
+
+		common.Info(fmt.Sprintf("Getting teacher tests"))
+		err = grader.GetTemplateSubDirectory(grader, grader.GetLocation()+"/"+rTestPath, subdirectoryToGet, subdirectoryPlacementName)
+
+		if err != nil {
+			return err
+		}
+
+		common.Info(fmt.Sprintf("Grading teacher test cases"))
+		err = r.gradeSteps()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *rGrader) gradeSteps() error {
+
+	common.Debug(fmt.Sprintf("Building and Grading Assignment"))
+	err := r.GradeTests(r.GetGrader())
+	if err != nil {
+		common.Warning(fmt.Sprintf("Grading Assignment Produced Error, although, this error means it just failed some tests: %s", err))
+	}
+	common.Debug(fmt.Sprintf("Sucessfully Tested Assignment"))
+
+	common.Debug(fmt.Sprintf("Creating Report from result of tests"))
+	err = r.GetUnitTestReport(r.GetGrader())
+	common.Debug(fmt.Sprintf("Got results from test"))
+	return nil
+}
+
+// scoreWithRubric
+// Scores the results that were just gathered with the rubric loaded from the teacher tests
+func (r *rGrader) scoreWithRubric(tests list.TestsLinkedList) {
+	if r.rubric == nil {
+		return
+	}
+
+	score := r.rubric.Score(tests)
+	if r.grader.data.GradingStudentTestCurrently {
+		r.StudentRubricScore = score
+	} else {
+		r.TeacherRubricScore = score
+	}
+	common.Debug(fmt.Sprintf("Rubric score: %.2f / %.2f", score.Total, score.Possible))
+}
+
+func (r *rGrader) GetUnitTestReport(grader graderStruct) error {
+	repoName := grader.GetLocation() + grader.data.submissionTestPath
+	common.Debug(fmt.Sprintf("Getting parser factory object"))
+	parser, err := parserFactory.GetParser("r", repoName, grader.GetLocation(), !r.grader.data.FailedToGetCoverage)
+	if err != nil {
+		common.Error(fmt.Sprintf("Failed to create parser: %s", err))
+		return err
+	}
+
+	common.Debug(fmt.Sprintf("Parsing Results"))
+	err = parser.ParseTestResults()
+	if err != nil {
+		common.Error(fmt.Sprintf("Error parsing results: %s", err))
+		r.grader.data.FailedToCompile = true
+		return err
+	}
+
+	if r.grader.data.GradingStudentTestCurrently {
+		common.Debug(fmt.Sprintf("Student Test Results Gathered"))
+		r.grader.data.StudentTestResults = parser.UnitTestResultsAndCoverage
+	} else {
+		common.Debug(fmt.Sprintf("Teacher Test Results Gathered"))
+		r.grader.data.TeacherTestResults = parser.UnitTestResultsAndCoverage
+	}
+
+	common.Debug(fmt.Sprintf("Scoring results with rubric"))
+	r.scoreWithRubric(parser.UnitTestResultsAndCoverage.UnitTestResults)
+	return err
+}
+
+func (r rGrader) BuildProject(grader graderStruct) error {
+
+	return nil
+}
+
+// GradeTests
+// Runs testthat with the JUnit reporter so the results can be read by the R parser,
+// then runs covr over the same tests to get the coverage
+func (r *rGrader) GradeTests(grader graderStruct) error {
+	resultDir := grader.GetLocation() + grader.data.submissionTestPath
+	common.MakeDir(resultDir)
+
+	testScript := getRTestScript(rTestPath, resultDir)
+	rootPath := grader.data.assignmentRootPath
+	readOnlyPaths := []string{rootPath + "/" + rTestPath + "/teacher"}
+
+	// Kills command if taking too long
+	timeoutMillisecondsBound := r.grader.data.maxTestingTimeUpperBound
+	convertToMillisecondsBound, _ := strconv.Atoi(timeoutMillisecondsBound)
+	timeoutBound := time.Millisecond * time.Duration(convertToMillisecondsBound)
+	testContext, cancelTest := context.WithTimeout(context.Background(), timeoutBound)
+	defer cancelTest()
+
+	result := r.sandbox.Run(testContext, rootPath, readOnlyPaths, false, "Rscript", "-e", testScript)
+	r.recordSandboxResult(result)
+	err := result.Err
+
+	if err != nil {
+		if result.Outcome == SandboxOutcomeTimeout {
+			r.grader.data.exceededUpperBound = true
+			r.grader.data.FailedToGetCoverage = true
+			common.MakeDir(resultDir)
+			common.Warning(fmt.Sprintf("testthat ran for longer than %d ms and was stopped", convertToMillisecondsBound))
+			message := fmt.Sprintf("testthat ran for longer than %d ms and was stopped. This could mean an infinite loop exists in the code, or that not enough time was given for the tests to finish", convertToMillisecondsBound)
+			errWrite := parserTypes.WriteTimeoutReport(resultDir+rTimeoutReport, "testthat", nil, message)
+			if errWrite != nil {
+				return errWrite
+			}
+			return err
+		}
+		if result.Outcome != SandboxOutcomeFailed || result.ExitCode != 1 { // exit status 1 just means the test failed
+			return err
+		}
+	}
+
+	coverageScript := fmt.Sprintf("covr::to_cobertura(covr::file_coverage(list.files(%q, pattern = \"\\\\.[Rr]$\", full.names = TRUE, recursive = TRUE), list.files(%q, pattern = \"\\\\.[Rr]$\", full.names = TRUE, recursive = TRUE)), filename = %q)", rSourcePath, rTestPath, rootPath+rCoverageReport)
+	coverageContext, cancelCoverage := context.WithTimeout(context.Background(), timeoutBound)
+	defer cancelCoverage()
+	result = r.sandbox.Run(coverageContext, rootPath, readOnlyPaths, false, "Rscript", "-e", coverageScript)
+	r.recordSandboxResult(result)
+	err = result.Err
+
+	if err != nil {
+		common.Warning(fmt.Sprintf("Failed to get coverage report from covr: %s", err))
+		r.grader.data.FailedToGetCoverage = true
+		return err
+	}
+
+	return err
+}
+
+// getRTestScript
+// Every test file is ran on its own with its own JUnit file, so the files that
+// finished are still there if testthat has to be stopped for taking too long.
+// A file that errors before its tests run gets a JUnit file with a suite error in its place,
+// so it is graded as errored and the files after it, and covr, still run
+func getRTestScript(testPath string, resultDir string) string {
+	return fmt.Sprintf(`escapeXml <- function(s) {
+  for (entity in list(c("&", "&amp;"), c("<", "&lt;"), c(">", "&gt;"), c("\"", "&quot;"))) s <- gsub(entity[1], entity[2], s, fixed = TRUE)
+  s
+}
+testFiles <- list.files(%q, pattern = "^test.*\\.[Rr]$", recursive = TRUE, full.names = TRUE)
+for (i in seq_along(testFiles)) {
+  reportFile <- file.path(%q, paste0("TEST-testthat-", i, ".xml"))
+  tryCatch(
+    testthat::test_file(testFiles[i], reporter = testthat::JunitReporter$new(file = reportFile), stop_on_failure = FALSE),
+    error = function(e) {
+      reason <- escapeXml(conditionMessage(e))
+      writeLines(c(
+        "<?xml version=\"1.0\" encoding=\"UTF-8\"?>",
+        "<testsuites name=\"testthat\" tests=\"0\" failures=\"0\" errors=\"1\">",
+        sprintf("  <testsuite name=\"%%s\" tests=\"0\" skipped=\"0\" failures=\"0\" errors=\"1\" time=\"0\">", escapeXml(basename(testFiles[i]))),
+        sprintf("    <error message=\"%%s\">%%s</error>", reason, reason),
+        "  </testsuite>",
+        "</testsuites>"), reportFile)
+    })
+}`, testPath, resultDir)
+}
+
+// recordSandboxResult
+// Keeps track of the commands that broke a sandbox rule so they can be reported
+func (r *rGrader) recordSandboxResult(result SandboxResult) {
+	if logSandboxViolation(result) {
+		r.SandboxViolations = append(r.SandboxViolations, result)
+	}
+}
+
+func (r rGrader) NonCodeSubmissionEnabled(grader graderStruct) bool {
+	return grader.nonCodeSubmissionEnabled(grader)
+}
+
+func (r rGrader) ShouldGradeUnitTests(grader graderStruct) bool {
+	return grader.shouldGradeUnitTests(grader)
+}
+
+func (r rGrader) TeacherTestsEnabled(grader graderStruct) bool {
+	return grader.teacherTestsEnabled(grader)
+}
+
+func (r rGrader) PullTeacherTests(grader graderStruct) error {
+	return grader.pullTeacherTests(grader)
+}
+
+func (r rGrader) GetNonCodeSubmissions(grader graderStruct) error {
+	return grader.getNonCodeSubmissions(grader)
+}
+
+func (r rGrader) PullAssignment(grader graderStruct) error {
+	return grader.pullAssignment(grader)
+}
+
+func (r *rGrader) GetComplexity() {
+	r.grader.getComplexity()
+}
+
+func (r *rGrader) PullRepoAndCheckCommits() bool {
+	return r.grader.PullRepoAndCheckCommits()
+}
+
+func (r *rGrader) SetStatus(status string) {
+	r.grader.SetStatus(status)
+}
+
+func (r *rGrader) SetStatusMessage(statusMessage string) {
+	r.grader.SetStatusMessage(statusMessage)
+}
+
+func newRGrader() IGrader {
+	return &rGrader{
+		grader: graderStruct{
+			data: getGraderData("/tmp/r/", "/reports/"),
+		},
+		sandbox: newSandboxRunner(),
+	}
+}
@@ -0,0 +1,36 @@
+package graderFactory
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRTestScriptKeepsGoingAfterAnError(t *testing.T) {
+	if err := exec.Command("Rscript", "-e", "library(testthat)").Run(); err != nil {
+		t.Skipf("Rscript with testthat is needed: %s", err)
+	}
+	root := writeSubmission(t, map[string]string{
+		rTestPath + "/test-broken.R": "stop(\"could not load <data>\")\n",
+		rTestPath + "/test-sum.R":    "testthat::test_that(\"sum\", testthat::expect_equal(sum(1, 2), 3))\n",
+	})
+	resultDir := t.TempDir()
+	command := exec.Command("Rscript", "-e", getRTestScript(rTestPath, resultDir))
+	command.Dir = root
+	if output, err := command.CombinedOutput(); err != nil {
+		t.Fatalf("testthat stopped: %s\n%s", err, output)
+	}
+
+	broken, err := os.ReadFile(filepath.Join(resultDir, "TEST-testthat-1.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(broken), `errors="1"`) || !strings.Contains(string(broken), "could not load &lt;data&gt;") {
+		t.Errorf("report of the broken file = %s, want a suite error", broken)
+	}
+	if _, err := os.Stat(filepath.Join(resultDir, "TEST-testthat-2.xml")); err != nil {
+		t.Errorf("the file after the broken one was not ran: %s", err)
+	}
+}
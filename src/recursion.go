@@ -0,0 +1,52 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/common"
+	"SubmissionGrader/internal/complexity/complexCommons"
+	"fmt"
+	"os"
+)
+
+const (
+	callGraphJSON = "/call-graph.json"
+	callGraphDOT  = "/call-graph.dot"
+)
+
+// BuildTypescriptCallGraph
+// Resolves the calls of every parsed file across the whole submission
+func BuildTypescriptCallGraph(files []complexCommons.FileParseResult) complexCommons.CallGraph {
+	calls := make([]complexCommons.FileCalls, 0, len(files))
+	for _, file := range files {
+		calls = append(calls, file.Calls)
+	}
+	return complexCommons.BuildCallGraph(calls)
+}
+
+// writeCallGraph
+// Writes the graph as both JSON and DOT next to the submission
+func writeCallGraph(directory string, graph complexCommons.CallGraph) error {
+	content, err := graph.ToJSON()
+	if err != nil {
+		return err
+	}
+	const permission = 0777
+	err = os.WriteFile(directory+callGraphJSON, content, permission)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(directory+callGraphDOT, []byte(graph.ToDOT()), permission)
+}
+
+// logRecursiveFunctions
+// Reports every recursive function, saying whether it calls itself or goes through others
+func logRecursiveFunctions(graph complexCommons.CallGraph) {
+	for _, node := range graph.GetRecursiveNodes() {
+		kind := "directly"
+		if node.MutualRecursion && node.DirectRecursion {
+			kind = "directly and mutually"
+		} else if node.MutualRecursion {
+			kind = "mutually"
+		}
+		common.Debug(fmt.Sprintf("%s is %s recursive (%s:%d)", node.Key, kind, node.FileName, node.Line))
+	}
+}
@@ -0,0 +1,357 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/common"
+	"SubmissionGrader/internal/complexity/complexCommons"
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+	"SubmissionGrader/internal/parser/parserTypes/list"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// The rubric is kept with the teacher tests, so it is versioned along with them
+const (
+	rubricFileName        = "rubric.json"
+	rubricPlacementName   = "teacher" // the directory loadTeacherRubric has the teacher tests placed in
+	rubricCheckoutPattern = "teacher-tests-"
+)
+
+// Rubric
+// Maps tests to the points they are worth.
+// An example of a rubric file:
+//
+//	{
+//	  "maxPoints": 100,
+//	  "items": [
+//	    { "name": "Stack", "classPattern": "^Stack", "points": 5, "cap": 40, "partialCredit": true },
+//	    { "name": "Edge cases", "namePattern": "empty|null", "points": 10, "partialCredit": false }
+//	  ],
+//	  "complexityLimits": [
+//	    { "metric": "cognitive", "limit": 15, "deduction": 2, "maxDeduction": 10 },
+//	    { "metric": "nesting", "limit": 4, "deduction": 1 }
+//	  ],
+//	  "importPolicy": { "banned": ["lodash"], "deduction": 5 },
+//	  "duplicationLimit": { "maxPercentage": 10, "deduction": 5 }
+//	}
+type Rubric struct {
+	MaxPoints        float64           `json:"maxPoints"` // caps the total, 0 means no cap
+	Items            []RubricItem      `json:"items"`
+	ComplexityLimits []ComplexityLimit `json:"complexityLimits"`
+	ImportPolicy     *ImportPolicy     `json:"importPolicy"`     // nil when the assignment has no policy
+	DuplicationLimit *DuplicationLimit `json:"duplicationLimit"` // nil when copies are not checked
+}
+
+// RubricItem
+// Every test whose class name and name match the patterns (regular expressions,
+// empty matches everything) belongs to this item and earns Points when it passes.
+// Without partial credit the item earns nothing unless all of its tests pass
+type RubricItem struct {
+	Name          string  `json:"name"`
+	ClassPattern  string  `json:"classPattern"`
+	NamePattern   string  `json:"namePattern"`
+	Points        float64 `json:"points"`
+	Cap           float64 `json:"cap"` // 0 means no cap
+	PartialCredit bool    `json:"partialCredit"`
+
+	classRegex *regexp.Regexp
+	nameRegex  *regexp.Regexp
+}
+
+// The metrics of a method that a complexity limit can be put on
+const (
+	metricCyclomatic         = "cyclomatic"
+	metricCognitive          = "cognitive"
+	metricNesting            = "nesting"
+	metricLines              = "lines"
+	metricHalsteadVolume     = "halsteadVolume"
+	metricHalsteadDifficulty = "halsteadDifficulty"
+)
+
+// ComplexityLimit
+// Every method with a metric above the limit costs Deduction points
+type ComplexityLimit struct {
+	Metric       string  `json:"metric"`
+	Limit        float64 `json:"limit"`
+	Deduction    float64 `json:"deduction"`
+	MaxDeduction float64 `json:"maxDeduction"` // 0 means no cap
+}
+
+// DuplicationLimit
+// A submission with more than MaxPercentage of its method tokens in copied methods loses Deduction points
+type DuplicationLimit struct {
+	MaxPercentage float64 `json:"maxPercentage"`
+	Deduction     float64 `json:"deduction"`
+}
+
+type DuplicationScore struct {
+	Percentage float64
+	Deduction  float64
+	Groups     []complexCommons.CloneGroup
+}
+
+type ComplexityViolation struct {
+	Location  string
+	Class     string
+	Method    string
+	StartLine int
+	EndLine   int
+	Metric    string
+	Value     float64
+	Limit     float64
+}
+
+type ComplexityScore struct {
+	Deduction  float64
+	Violations []ComplexityViolation
+}
+
+type ImportScore struct {
+	Deduction  float64
+	Violations []ImportViolation
+}
+
+type RubricScore struct {
+	Total    float64
+	Possible float64
+	Items    []RubricItemScore
+
+	ComplexityDeduction  float64
+	ComplexityViolations []ComplexityViolation
+
+	ImportDeduction  float64
+	ImportViolations []ImportViolation
+
+	DuplicationPercentage float64
+	DuplicationDeduction  float64
+}
+
+type RubricItemScore struct {
+	Name     string
+	Matched  int
+	Passed   int
+	Earned   float64
+	Possible float64
+}
+
+// LoadRubric
+// Reads the rubric file and compiles all of its patterns
+func LoadRubric(location string) (Rubric, error) {
+	content, err := os.ReadFile(location)
+	if err != nil {
+		return Rubric{}, err
+	}
+
+	var rubric Rubric
+	err = json.Unmarshal(content, &rubric)
+	if err != nil {
+		return Rubric{}, err
+	}
+
+	for _, limit := range rubric.ComplexityLimits {
+		if _, known := getMethodMetric(methodInfoType.MethodInfo{}, limit.Metric); !known {
+			return Rubric{}, fmt.Errorf("rubric has a complexity limit on an unknown metric: %s", limit.Metric)
+		}
+	}
+
+	for i := range rubric.Items {
+		item := &rubric.Items[i]
+		item.classRegex, err = regexp.Compile(item.ClassPattern)
+		if err != nil {
+			return Rubric{}, fmt.Errorf("rubric item %s has a bad class pattern: %w", item.Name, err)
+		}
+		item.nameRegex, err = regexp.Compile(item.NamePattern)
+		if err != nil {
+			return Rubric{}, fmt.Errorf("rubric item %s has a bad name pattern: %w", item.Name, err)
+		}
+	}
+	return rubric, nil
+}
+
+// Score
+// Goes through each rubric item and adds up the points of the tests matching it
+func (r Rubric) Score(tests list.TestsLinkedList) RubricScore {
+	score := RubricScore{}
+
+	for _, item := range r.Items {
+		itemScore := RubricItemScore{Name: item.Name}
+		for node := tests.Head; node != nil; node = node.Next {
+			if !item.matches(node.ClassName, node.Test) {
+				continue
+			}
+			itemScore.Matched++
+			if node.Test.Outcome == "PASSED" {
+				itemScore.Passed++
+			}
+		}
+
+		itemScore.Possible = capPoints(item.Points*float64(itemScore.Matched), item.Cap)
+		if item.PartialCredit || itemScore.Passed == itemScore.Matched {
+			itemScore.Earned = capPoints(item.Points*float64(itemScore.Passed), item.Cap)
+		}
+
+		score.Total += itemScore.Earned
+		score.Possible += itemScore.Possible
+		score.Items = append(score.Items, itemScore)
+	}
+
+	score.Total = capPoints(score.Total, r.MaxPoints)
+	score.Possible = capPoints(score.Possible, r.MaxPoints)
+	return score
+}
+
+// ScoreComplexity
+// Checks every method against the complexity limits, listing each method over a limit
+func (r Rubric) ScoreComplexity(methods []methodInfoType.MethodInfo) ComplexityScore {
+	score := ComplexityScore{}
+
+	for _, limit := range r.ComplexityLimits {
+		deduction := 0.0
+		for _, method := range methods {
+			value, _ := getMethodMetric(method, limit.Metric)
+			if value <= limit.Limit {
+				continue
+			}
+			deduction += limit.Deduction
+			score.Violations = append(score.Violations, ComplexityViolation{
+				Location:  method.Location,
+				Class:     method.Class,
+				Method:    method.MethodName,
+				StartLine: method.StartLine,
+				EndLine:   method.EndLine,
+				Metric:    limit.Metric,
+				Value:     value,
+				Limit:     limit.Limit,
+			})
+		}
+		score.Deduction += capPoints(deduction, limit.MaxDeduction)
+	}
+	return score
+}
+
+// WithComplexity
+// Takes the complexity deductions off the total, never going below 0
+func (s RubricScore) WithComplexity(complexity ComplexityScore) RubricScore {
+	s.ComplexityDeduction = complexity.Deduction
+	s.ComplexityViolations = complexity.Violations
+	s.Total = math.Max(0, s.Total-complexity.Deduction)
+	return s
+}
+
+// ScoreImports
+// Checks the dependency graph of the submission against the import policy
+func (r Rubric) ScoreImports(graph complexCommons.DependencyGraph) ImportScore {
+	score := ImportScore{}
+	if r.ImportPolicy == nil {
+		return score
+	}
+	score.Violations = r.ImportPolicy.Check(graph)
+	score.Deduction = capPoints(r.ImportPolicy.Deduction*float64(len(score.Violations)), r.ImportPolicy.MaxDeduction)
+	return score
+}
+
+// WithImports
+// Takes the import policy deductions off the total, never going below 0
+func (s RubricScore) WithImports(imports ImportScore) RubricScore {
+	s.ImportDeduction = imports.Deduction
+	s.ImportViolations = imports.Violations
+	s.Total = math.Max(0, s.Total-imports.Deduction)
+	return s
+}
+
+// ScoreDuplication
+// Checks how much of the submission is copied against the duplication limit
+func (r Rubric) ScoreDuplication(report complexCommons.CloneReport) DuplicationScore {
+	score := DuplicationScore{Percentage: report.DuplicationPercentage, Groups: report.Groups}
+	if r.DuplicationLimit != nil && report.DuplicationPercentage > r.DuplicationLimit.MaxPercentage {
+		score.Deduction = r.DuplicationLimit.Deduction
+	}
+	return score
+}
+
+// WithDuplication
+// Takes the duplication deduction off the total, never going below 0
+func (s RubricScore) WithDuplication(duplication DuplicationScore) RubricScore {
+	s.DuplicationPercentage = duplication.Percentage
+	s.DuplicationDeduction = duplication.Deduction
+	s.Total = math.Max(0, s.Total-duplication.Deduction)
+	return s
+}
+
+func getMethodMetric(method methodInfoType.MethodInfo, metric string) (float64, bool) {
+	switch metric {
+	case metricCyclomatic:
+		return float64(method.CycCount), true
+	case metricCognitive:
+		return float64(method.CogCount), true
+	case metricNesting:
+		return float64(method.MaxNesting), true
+	case metricLines:
+		return float64(method.TotalLine), true
+	case metricHalsteadVolume:
+		return method.Halstead.Volume, true
+	case metricHalsteadDifficulty:
+		return method.Halstead.Difficulty, true
+	}
+	return 0, false
+}
+
+func (item RubricItem) matches(className string, test list.UnitTest) bool {
+	return item.classRegex.MatchString(className) && item.nameRegex.MatchString(test.Name)
+}
+
+func capPoints(points float64, limit float64) float64 {
+	if limit > 0 && points > limit {
+		return limit
+	}
+	return points
+}
+
+// findRubric
+// Loads the rubric at the location. If there is no rubric, nil is returned as there is nothing to score
+func findRubric(location string) (*Rubric, error) {
+	if _, err := os.Stat(location); err != nil {
+		common.Debug(fmt.Sprintf("No rubric found at %s, skipping scoring", location))
+		return nil, nil
+	}
+
+	rubric, err := LoadRubric(location)
+	if err != nil {
+		return nil, err
+	}
+	return &rubric, nil
+}
+
+// loadTeacherRubric
+// Loads the rubric from a checkout of the teacher tests that pull places in a directory of the grader's own,
+// which is removed afterwards. The rubric is never read from the submission, where the student could have
+// written one, so a rubric.json in the teacher tests of the submission is removed
+func loadTeacherRubric(submissionTeacherDirectory string, pull func(directory string) error) (*Rubric, error) {
+	rejectSubmissionRubric(submissionTeacherDirectory)
+
+	directory, err := os.MkdirTemp("", rubricCheckoutPattern)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(directory)
+	if err := pull(directory); err != nil {
+		return nil, err
+	}
+	return findRubric(filepath.Join(directory, rubricPlacementName, rubricFileName))
+}
+
+// rejectSubmissionRubric
+// Removes the rubric found in the teacher tests of the submission before any of its tests run
+func rejectSubmissionRubric(submissionTeacherDirectory string) {
+	location := filepath.Join(submissionTeacherDirectory, rubricFileName)
+	if _, err := os.Stat(location); err != nil {
+		return
+	}
+	common.Warning(fmt.Sprintf("Ignoring the rubric found in the submission, it is only loaded from the teacher tests: %s", location))
+	if err := os.Remove(location); err != nil {
+		common.Error(fmt.Sprintf("Failed to remove the rubric found in the submission: %s", err))
+	}
+}
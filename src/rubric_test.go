@@ -0,0 +1,406 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/complexity/complexCommons"
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+	"SubmissionGrader/internal/parser/parserTypes/list"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// loadTestRubric
+// Writes the rubric to a file and loads it, failing the test if it cannot be loaded
+func loadTestRubric(t *testing.T, content string) Rubric {
+	t.Helper()
+	location := filepath.Join(t.TempDir(), rubricFileName)
+	if err := os.WriteFile(location, []byte(content), 0666); err != nil {
+		t.Fatal(err)
+	}
+	rubric, err := LoadRubric(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rubric
+}
+
+func testList(tests ...[3]string) list.TestsLinkedList {
+	results := list.TestsLinkedList{}
+	for _, test := range tests {
+		results.AddTest(test[0], list.UnitTest{Name: test[1], Outcome: test[2]})
+	}
+	return results
+}
+
+func TestRubricScore(t *testing.T) {
+	results := testList(
+		[3]string{"StackTest", "push adds an item", "PASSED"},
+		[3]string{"StackTest", "pop removes an item", "PASSED"},
+		[3]string{"StackTest", "pop on empty throws", "FAILED"},
+		[3]string{"QueueTest", "enqueue adds an item", "PASSED"},
+		[3]string{"QueueTest", "dequeue on empty returns null", "PASSED"},
+	)
+
+	tests := []struct {
+		name     string
+		rubric   string
+		total    float64
+		possible float64
+		earned   []float64
+	}{
+		{
+			name:     "partial credit",
+			rubric:   `{"items": [{"name": "Stack", "classPattern": "^Stack", "points": 5, "partialCredit": true}]}`,
+			total:    10,
+			possible: 15,
+			earned:   []float64{10},
+		},
+		{
+			name:     "all or nothing",
+			rubric:   `{"items": [{"name": "Stack", "classPattern": "^Stack", "points": 5}, {"name": "Queue", "classPattern": "^Queue", "points": 5}]}`,
+			total:    10,
+			possible: 25,
+			earned:   []float64{0, 10},
+		},
+		{
+			name:     "name pattern across classes",
+			rubric:   `{"items": [{"name": "Edge cases", "namePattern": "empty|null", "points": 10, "partialCredit": true}]}`,
+			total:    10,
+			possible: 20,
+			earned:   []float64{10},
+		},
+		{
+			name:     "cap on an item",
+			rubric:   `{"items": [{"name": "Everything", "points": 5, "cap": 12, "partialCredit": true}]}`,
+			total:    12,
+			possible: 12,
+			earned:   []float64{12},
+		},
+		{
+			name:     "max points",
+			rubric:   `{"maxPoints": 8, "items": [{"name": "Everything", "points": 5, "partialCredit": true}]}`,
+			total:    8,
+			possible: 8,
+			earned:   []float64{20},
+		},
+		{
+			name:     "no matching tests",
+			rubric:   `{"items": [{"name": "Heap", "classPattern": "^Heap", "points": 5}]}`,
+			total:    0,
+			possible: 0,
+			earned:   []float64{0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			score := loadTestRubric(t, test.rubric).Score(results)
+			if score.Total != test.total || score.Possible != test.possible {
+				t.Errorf("score = %.2f / %.2f, want %.2f / %.2f", score.Total, score.Possible, test.total, test.possible)
+			}
+			if len(score.Items) != len(test.earned) {
+				t.Fatalf("scored %d items, want %d", len(score.Items), len(test.earned))
+			}
+			for i, earned := range test.earned {
+				if score.Items[i].Earned != earned {
+					t.Errorf("item %s earned %.2f, want %.2f", score.Items[i].Name, score.Items[i].Earned, earned)
+				}
+			}
+		})
+	}
+}
+
+func TestScoreComplexity(t *testing.T) {
+	methods := []methodInfoType.MethodInfo{
+		{Class: "Stack", MethodName: "push", StartLine: 3, EndLine: 9, TotalLine: 7, CycCount: 3, CogCount: 4, MaxNesting: 2},
+		{Class: "Stack", MethodName: "sort", StartLine: 11, EndLine: 60, TotalLine: 50, CycCount: 12, CogCount: 22, MaxNesting: 5},
+		{MethodName: "parse", StartLine: 62, EndLine: 90, TotalLine: 29, CycCount: 9, CogCount: 16, MaxNesting: 4,
+			Halstead: methodInfoType.HalsteadMetrics{Volume: 800, Difficulty: 30}},
+	}
+
+	tests := []struct {
+		name       string
+		rubric     string
+		deduction  float64
+		violations []string
+	}{
+		{
+			name:       "one limit",
+			rubric:     `{"complexityLimits": [{"metric": "cognitive", "limit": 15, "deduction": 2}]}`,
+			deduction:  4,
+			violations: []string{"sort cognitive", "parse cognitive"},
+		},
+		{
+			name:       "a value at the limit is fine",
+			rubric:     `{"complexityLimits": [{"metric": "nesting", "limit": 4, "deduction": 1}]}`,
+			deduction:  1,
+			violations: []string{"sort nesting"},
+		},
+		{
+			name:       "max deduction",
+			rubric:     `{"complexityLimits": [{"metric": "cyclomatic", "limit": 2, "deduction": 2, "maxDeduction": 5}]}`,
+			deduction:  5,
+			violations: []string{"push cyclomatic", "sort cyclomatic", "parse cyclomatic"},
+		},
+		{
+			name:       "several limits",
+			rubric:     `{"complexityLimits": [{"metric": "lines", "limit": 40, "deduction": 3}, {"metric": "halsteadVolume", "limit": 500, "deduction": 1.5}]}`,
+			deduction:  4.5,
+			violations: []string{"sort lines", "parse halsteadVolume"},
+		},
+		{
+			name:      "no limits",
+			rubric:    `{"items": []}`,
+			deduction: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			score := loadTestRubric(t, test.rubric).ScoreComplexity(methods)
+			if score.Deduction != test.deduction {
+				t.Errorf("deduction = %.2f, want %.2f", score.Deduction, test.deduction)
+			}
+			if len(score.Violations) != len(test.violations) {
+				t.Fatalf("violations = %v, want %v", score.Violations, test.violations)
+			}
+			for i, violation := range score.Violations {
+				if got := violation.Method + " " + violation.Metric; got != test.violations[i] {
+					t.Errorf("violation %d = %s, want %s", i, got, test.violations[i])
+				}
+			}
+		})
+	}
+}
+
+func TestScoreComplexityViolation(t *testing.T) {
+	rubric := loadTestRubric(t, `{"complexityLimits": [{"metric": "cognitive", "limit": 15, "deduction": 2}]}`)
+	score := rubric.ScoreComplexity([]methodInfoType.MethodInfo{
+		{Location: "src/stack.ts", Class: "Stack", MethodName: "sort", StartLine: 11, EndLine: 60, CogCount: 22},
+	})
+
+	want := ComplexityViolation{Location: "src/stack.ts", Class: "Stack", Method: "sort", StartLine: 11, EndLine: 60, Metric: "cognitive", Value: 22, Limit: 15}
+	if len(score.Violations) != 1 || score.Violations[0] != want {
+		t.Errorf("violations = %+v, want %+v", score.Violations, want)
+	}
+}
+
+func TestWithComplexity(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     float64
+		deduction float64
+		want      float64
+	}{
+		{name: "deducted", total: 80, deduction: 6, want: 74},
+		{name: "never below zero", total: 4, deduction: 6, want: 0},
+		{name: "nothing to deduct", total: 80, deduction: 0, want: 80},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			score := RubricScore{Total: test.total, Possible: 100}.WithComplexity(ComplexityScore{Deduction: test.deduction})
+			if score.Total != test.want {
+				t.Errorf("total = %.2f, want %.2f", score.Total, test.want)
+			}
+			if score.Possible != 100 || score.ComplexityDeduction != test.deduction {
+				t.Errorf("possible = %.2f and deduction = %.2f, want 100 and %.2f", score.Possible, score.ComplexityDeduction, test.deduction)
+			}
+		})
+	}
+}
+
+func TestScoreDuplication(t *testing.T) {
+	report := complexCommons.CloneReport{
+		Groups:                []complexCommons.CloneGroup{{Type: complexCommons.CloneTypeRenamed, Similarity: 1}},
+		TotalTokens:           200,
+		DuplicatedTokens:      50,
+		DuplicationPercentage: 25,
+	}
+
+	tests := []struct {
+		name      string
+		rubric    string
+		deduction float64
+	}{
+		{name: "over the limit", rubric: `{"duplicationLimit": {"maxPercentage": 20, "deduction": 3}}`, deduction: 3},
+		{name: "at the limit", rubric: `{"duplicationLimit": {"maxPercentage": 25, "deduction": 3}}`, deduction: 0},
+		{name: "no limit", rubric: `{"items": []}`, deduction: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			score := loadTestRubric(t, test.rubric).ScoreDuplication(report)
+			if score.Deduction != test.deduction {
+				t.Errorf("deduction = %.2f, want %.2f", score.Deduction, test.deduction)
+			}
+			if score.Percentage != 25 || len(score.Groups) != 1 {
+				t.Errorf("score = %.2f%% in %d groups, want 25%% in 1 group", score.Percentage, len(score.Groups))
+			}
+
+			total := RubricScore{Total: 10}.WithDuplication(score)
+			if total.Total != 10-test.deduction || total.DuplicationDeduction != test.deduction || total.DuplicationPercentage != 25 {
+				t.Errorf("total = %.2f with %.2f deducted for %.2f%%, want %.2f", total.Total, total.DuplicationDeduction, total.DuplicationPercentage, 10-test.deduction)
+			}
+		})
+	}
+
+	if total := (RubricScore{Total: 2}).WithDuplication(DuplicationScore{Deduction: 3}); total.Total != 0 {
+		t.Errorf("total = %.2f, want it to stop at 0", total.Total)
+	}
+}
+
+func TestScoreImports(t *testing.T) {
+	graph := complexCommons.DependencyGraph{
+		Edges: []complexCommons.DependencyEdge{
+			{From: "src/stack.ts", To: "lodash", Module: "lodash/fp", Line: 1, External: true},
+			{From: "src/queue.ts", To: "lodash", Module: "lodash", Line: 2, External: true},
+			{From: "src/queue.ts", To: "src/stack.ts", Module: "./stack", Line: 3},
+		},
+		Cycles: [][]string{{"src/stack.ts", "src/queue.ts"}},
+	}
+
+	tests := []struct {
+		name       string
+		rubric     string
+		deduction  float64
+		violations []string
+	}{
+		{
+			name:       "banned package",
+			rubric:     `{"importPolicy": {"banned": ["lodash"], "allowCycles": true, "deduction": 2}}`,
+			deduction:  4,
+			violations: []string{"banned", "banned"},
+		},
+		{
+			name:       "cycle and max deduction",
+			rubric:     `{"importPolicy": {"banned": ["lodash"], "deduction": 2, "maxDeduction": 5}}`,
+			deduction:  5,
+			violations: []string{"banned", "banned", "cycle"},
+		},
+		{
+			name:       "violations without a deduction",
+			rubric:     `{"importPolicy": {"allowed": ["react"], "allowCycles": true}}`,
+			deduction:  0,
+			violations: []string{"notAllowed", "notAllowed"},
+		},
+		{
+			name:      "no policy",
+			rubric:    `{"items": []}`,
+			deduction: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			score := loadTestRubric(t, test.rubric).ScoreImports(graph)
+			if score.Deduction != test.deduction {
+				t.Errorf("deduction = %.2f, want %.2f", score.Deduction, test.deduction)
+			}
+			if len(score.Violations) != len(test.violations) {
+				t.Fatalf("violations = %v, want %v", score.Violations, test.violations)
+			}
+			for i, violation := range score.Violations {
+				if violation.Rule != test.violations[i] {
+					t.Errorf("violation %d broke %s, want %s", i, violation.Rule, test.violations[i])
+				}
+			}
+
+			total := RubricScore{Total: 10}.WithImports(score)
+			if total.Total != 10-test.deduction || total.ImportDeduction != test.deduction {
+				t.Errorf("total = %.2f with %.2f deducted, want %.2f", total.Total, total.ImportDeduction, 10-test.deduction)
+			}
+		})
+	}
+}
+
+func TestLoadRubricErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		rubric string
+	}{
+		{name: "not json", rubric: `items: []`},
+		{name: "bad class pattern", rubric: `{"items": [{"name": "Stack", "classPattern": "(Stack"}]}`},
+		{name: "bad name pattern", rubric: `{"items": [{"name": "Stack", "namePattern": "[push"}]}`},
+		{name: "unknown metric", rubric: `{"complexityLimits": [{"metric": "beauty", "limit": 1}]}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			location := filepath.Join(t.TempDir(), rubricFileName)
+			if err := os.WriteFile(location, []byte(test.rubric), 0666); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadRubric(location); err == nil {
+				t.Errorf("loading %s gave no error", test.rubric)
+			}
+		})
+	}
+}
+
+func TestFindRubricWithoutFile(t *testing.T) {
+	rubric, err := findRubric(filepath.Join(t.TempDir(), rubricFileName))
+	if rubric != nil || err != nil {
+		t.Errorf("findRubric = %v, %v, want nil, nil", rubric, err)
+	}
+}
+
+func TestLoadTeacherRubric(t *testing.T) {
+	teacherRubric := `{"maxPoints": 10, "items": [{"name": "Stack", "points": 10}]}`
+	studentRubric := `{"maxPoints": 1000, "items": [{"name": "Everything", "points": 1000}]}`
+	pullRubric := func(directory string) error {
+		teacher := filepath.Join(directory, rubricPlacementName)
+		if err := os.MkdirAll(teacher, 0777); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(teacher, rubricFileName), []byte(teacherRubric), 0666)
+	}
+	pullNothing := func(directory string) error { return nil }
+
+	tests := []struct {
+		name      string
+		pull      func(directory string) error
+		maxPoints float64 // 0 when no rubric is loaded
+	}{
+		{name: "teacher rubric is loaded instead of the student one", pull: pullRubric, maxPoints: 10},
+		{name: "student rubric is not loaded without a teacher one", pull: pullNothing},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			submissionTeacher := filepath.Join(t.TempDir(), "src", "test", "typescript", "teacher")
+			if err := os.MkdirAll(submissionTeacher, 0777); err != nil {
+				t.Fatal(err)
+			}
+			studentLocation := filepath.Join(submissionTeacher, rubricFileName)
+			if err := os.WriteFile(studentLocation, []byte(studentRubric), 0666); err != nil {
+				t.Fatal(err)
+			}
+
+			var checkout string
+			rubric, err := loadTeacherRubric(submissionTeacher, func(directory string) error {
+				checkout = directory
+				return test.pull(directory)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			maxPoints := 0.0
+			if rubric != nil {
+				maxPoints = rubric.MaxPoints
+			}
+			if maxPoints != test.maxPoints {
+				t.Errorf("max points = %.0f, want %.0f", maxPoints, test.maxPoints)
+			}
+			if _, err := os.Stat(studentLocation); !os.IsNotExist(err) {
+				t.Error("the rubric of the submission was not removed")
+			}
+			if _, err := os.Stat(checkout); !os.IsNotExist(err) {
+				t.Error("the checkout of the teacher tests was not removed")
+			}
+		})
+	}
+
+	if _, err := loadTeacherRubric(t.TempDir(), func(string) error { return os.ErrPermission }); err == nil {
+		t.Error("a failed pull gave no error")
+	}
+}
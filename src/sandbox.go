@@ -0,0 +1,389 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/common"
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Outcomes of running a command in the sandbox.
+// Anything other than OK, FAILED and TIMEOUT means the command broke one of the sandbox rules.
+// Going over the process limit, writing to a read only path or using the network are blocked too,
+// but the command only sees a failed call, so it fails like any other command would
+const (
+	SandboxOutcomeOK            = "OK"
+	SandboxOutcomeFailed        = "FAILED" // ran fine but exited with a non zero status, such as failing tests
+	SandboxOutcomeTimeout       = "TIMEOUT"
+	SandboxOutcomeCPULimit      = "CPU_LIMIT_EXCEEDED"
+	SandboxOutcomeMemoryLimit   = "MEMORY_LIMIT_EXCEEDED"
+	SandboxOutcomeFileSizeLimit = "FILE_SIZE_LIMIT_EXCEEDED"
+	SandboxOutcomeUnavailable   = "SANDBOX_UNAVAILABLE"
+)
+
+// Only the end of the output is kept, which is used for the tests that finished before a timeout
+const sandboxMaxOutputBytes = 1024 * 1024
+
+// How long to wait for the output to be closed once the command exited or was killed,
+// since a process it left behind could keep it open forever
+const sandboxWaitDelay = 5 * time.Second
+
+// How long to wait for the reaper to report how the command exited once the sandbox is gone
+const sandboxStatusDelay = time.Second
+
+// When set, the grader was started inside the sandbox as the reaper of the command,
+// and reports how the command exited on the file descriptor it holds
+const sandboxReaperFDEnv = "SANDBOX_REAPER_FD"
+
+// The file descriptor of the status pipe inside the sandbox, the first of ExtraFiles
+const sandboxReaperFD = 3
+
+// Directories of the host that are visible (read only) inside the sandbox so languages and tools can run
+var sandboxSystemPaths = []string{"/usr", "/bin", "/sbin", "/lib", "/lib64", "/etc", "/opt"}
+
+type SandboxLimits struct {
+	CPUSeconds       uint64
+	MemoryBytes      uint64
+	MaxProcesses     uint64
+	MaxFileSizeBytes uint64
+}
+
+type SandboxResult struct {
+	Outcome  string
+	ExitCode int
+	Output   string
+	Err      error
+}
+
+// IsViolation
+// True when the command was stopped for breaking a sandbox rule
+func (r SandboxResult) IsViolation() bool {
+	switch r.Outcome {
+	case SandboxOutcomeOK, SandboxOutcomeFailed, SandboxOutcomeTimeout:
+		return false
+	}
+	return true
+}
+
+// logSandboxViolation
+// Logs the result if the command broke a sandbox rule and returns if it did
+func logSandboxViolation(result SandboxResult) bool {
+	if !result.IsViolation() {
+		return false
+	}
+	if result.Outcome == SandboxOutcomeUnavailable {
+		common.Error(fmt.Sprintf("Sandbox could not run the command: %s", result.Err))
+	} else {
+		common.Warning(fmt.Sprintf("Command was stopped by the sandbox: %s", result.Outcome))
+	}
+	return true
+}
+
+// sandboxRunner
+// Every command that runs student code goes through here.
+// The command is put in its own user, pid, ipc and network namespaces with bubblewrap,
+// where only the assignment directory can be written (and the teacher tests can't),
+// and is limited with rlimits set by prlimit
+type sandboxRunner struct {
+	limits       SandboxLimits
+	bwrapPath    string
+	prlimitPath  string
+	reaperPath   string
+	disabled     bool
+	lookupFailed error
+}
+
+// newSandboxRunner
+// Limits can be changed with the SANDBOX_CPU_SECONDS, SANDBOX_MEMORY_MB,
+// SANDBOX_MAX_PROCESSES and SANDBOX_MAX_FILE_SIZE_MB environment variables.
+// SANDBOX_DISABLED=true runs the commands directly, which should only ever be used for local development
+func newSandboxRunner() *sandboxRunner {
+	runner := &sandboxRunner{
+		limits: SandboxLimits{
+			CPUSeconds:       getSandboxLimitFromEnv("SANDBOX_CPU_SECONDS", 300),
+			MemoryBytes:      getSandboxLimitFromEnv("SANDBOX_MEMORY_MB", 2048) * 1024 * 1024,
+			MaxProcesses:     getSandboxLimitFromEnv("SANDBOX_MAX_PROCESSES", 512),
+			MaxFileSizeBytes: getSandboxLimitFromEnv("SANDBOX_MAX_FILE_SIZE_MB", 64) * 1024 * 1024,
+		},
+		disabled: os.Getenv("SANDBOX_DISABLED") == "true",
+	}
+
+	var err error
+	runner.bwrapPath, err = exec.LookPath("bwrap")
+	if err != nil {
+		runner.lookupFailed = err
+	}
+	runner.prlimitPath, err = exec.LookPath("prlimit")
+	if err != nil {
+		runner.lookupFailed = err
+	}
+	runner.reaperPath, err = os.Executable()
+	if err != nil {
+		runner.lookupFailed = err
+	}
+	return runner
+}
+
+func getSandboxLimitFromEnv(name string, defaultValue uint64) uint64 {
+	value, err := strconv.ParseUint(os.Getenv(name), 10, 64)
+	if err != nil || value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// Run
+// Runs the command inside the sandbox with dir as the only writable directory.
+// readOnlyPaths are inside dir but can not be changed (such as the teacher tests).
+// When ctx is done the command is killed along with every process it started
+func (s *sandboxRunner) Run(ctx context.Context, dir string, readOnlyPaths []string, allowNetwork bool, name string, args ...string) SandboxResult {
+	var cmd *exec.Cmd
+	var statusReader, statusWriter *os.File
+	if s.disabled {
+		common.Warning(fmt.Sprintf("Sandbox is disabled, running %s directly on the host", name))
+		cmd = exec.CommandContext(ctx, name, args...)
+	} else if s.lookupFailed != nil {
+		return SandboxResult{Outcome: SandboxOutcomeUnavailable, ExitCode: -1, Err: fmt.Errorf("sandbox could not be started, bwrap and prlimit are required: %w", s.lookupFailed)}
+	} else {
+		var err error
+		statusReader, statusWriter, err = os.Pipe()
+		if err != nil {
+			return SandboxResult{Outcome: SandboxOutcomeUnavailable, ExitCode: -1, Err: fmt.Errorf("sandbox could not be started: %w", err)}
+		}
+		defer statusReader.Close()
+		cmd = exec.CommandContext(ctx, s.bwrapPath, s.buildArguments(dir, readOnlyPaths, allowNetwork, name, args)...)
+		cmd.ExtraFiles = []*os.File{statusWriter}
+	}
+	cmd.Dir = dir
+	// Its own process group so the children (such as node started by npm) can be killed with it
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return killProcessGroup(cmd.Process.Pid)
+	}
+	cmd.WaitDelay = sandboxWaitDelay
+
+	output := &tailBuffer{limit: sandboxMaxOutputBytes}
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	err := cmd.Start()
+	if statusWriter != nil {
+		// Only the reaper in the sandbox may hold the write end, so the read ends once it is gone
+		statusWriter.Close()
+	}
+	if err == nil {
+		err = cmd.Wait()
+	}
+	result := SandboxResult{Outcome: SandboxOutcomeOK, ExitCode: 0, Output: output.String(), Err: err}
+	if err == nil {
+		return result
+	}
+	if ctx.Err() != nil {
+		result.Outcome = SandboxOutcomeTimeout
+		result.ExitCode = -1
+		return result
+	}
+	if errors.Is(err, exec.ErrWaitDelay) {
+		common.Warning(fmt.Sprintf("%s exited but left a process behind that kept its output open", name))
+		result.Err = nil
+		return result
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		result.Outcome = SandboxOutcomeUnavailable
+		result.ExitCode = -1
+		return result
+	}
+
+	var status exitStatus
+	if statusReader != nil {
+		status = readReaperStatus(statusReader, exitErr)
+	} else {
+		status = getExitStatus(exitErr)
+	}
+	result.ExitCode = status.code
+	result.Outcome = classifyExit(status)
+	return result
+}
+
+// killProcessGroup
+// Kills every process in the group led by pid.
+// Inside the sandbox, killing bwrap also takes down its whole pid namespace
+func killProcessGroup(pid int) error {
+	err := syscall.Kill(-pid, syscall.SIGKILL)
+	if err != nil && !errors.Is(err, syscall.ESRCH) {
+		common.Warning(fmt.Sprintf("Failed to kill process group %d: %s", pid, err))
+		return err
+	}
+	return nil
+}
+
+func (s *sandboxRunner) buildArguments(dir string, readOnlyPaths []string, allowNetwork bool, name string, args []string) []string {
+	bwrapArgs := []string{"--unshare-all", "--die-with-parent", "--new-session"}
+	if allowNetwork {
+		bwrapArgs = append(bwrapArgs, "--share-net")
+	}
+	for _, systemPath := range sandboxSystemPaths {
+		bwrapArgs = append(bwrapArgs, "--ro-bind-try", systemPath, systemPath)
+	}
+	bwrapArgs = append(bwrapArgs,
+		"--dev", "/dev",
+		"--proc", "/proc",
+		"--tmpfs", "/tmp",
+		"--setenv", "HOME", "/tmp",
+		"--setenv", sandboxReaperFDEnv, strconv.Itoa(sandboxReaperFD),
+		"--ro-bind", s.reaperPath, s.reaperPath,
+		"--bind", dir, dir)
+	for _, readOnlyPath := range readOnlyPaths { // must come after the bind of dir to cover it
+		bwrapArgs = append(bwrapArgs, "--ro-bind-try", readOnlyPath, readOnlyPath)
+	}
+	bwrapArgs = append(bwrapArgs, "--chdir", dir, "--", s.reaperPath)
+
+	bwrapArgs = append(bwrapArgs, s.prlimitPath,
+		fmt.Sprintf("--cpu=%d", s.limits.CPUSeconds),
+		fmt.Sprintf("--as=%d", s.limits.MemoryBytes), // the data limit does not cover mmap, which is how node and R get most of their memory
+		fmt.Sprintf("--nproc=%d", s.limits.MaxProcesses),
+		fmt.Sprintf("--fsize=%d", s.limits.MaxFileSizeBytes),
+		"--", name)
+	return append(bwrapArgs, args...)
+}
+
+// exitStatus
+// How the command exited, code is -1 when it was stopped by a signal
+type exitStatus struct {
+	code     int
+	signal   syscall.Signal
+	signaled bool
+}
+
+// getExitStatus
+// Only used when the command is the direct child of the grader
+func getExitStatus(exitErr *exec.ExitError) exitStatus {
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return exitStatus{code: -1, signal: status.Signal(), signaled: true}
+	}
+	return exitStatus{code: exitErr.ExitCode()}
+}
+
+// readReaperStatus
+// bwrap exits with 128 + the signal that stopped the command, which the command can also
+// just exit with, so how it exited is taken from the reaper instead.
+// Without a report (the command killed the reaper) the exit code of bwrap is used, but never as a signal
+func readReaperStatus(statusReader *os.File, exitErr *exec.ExitError) exitStatus {
+	status := exitStatus{code: exitErr.ExitCode()}
+	_ = statusReader.SetReadDeadline(time.Now().Add(sandboxStatusDelay))
+	report, _ := bufio.NewReader(statusReader).ReadString('\n')
+
+	var kind string
+	var value int
+	if _, err := fmt.Sscanf(strings.TrimSpace(report), "%s %d", &kind, &value); err != nil {
+		common.Warning(fmt.Sprintf("Sandbox did not report how the command exited, using the exit code %d", status.code))
+		return status
+	}
+	switch kind {
+	case "signaled":
+		return exitStatus{code: -1, signal: syscall.Signal(value), signaled: true}
+	case "exited":
+		return exitStatus{code: value}
+	}
+	return status
+}
+
+// classifyExit
+// Works out from how a failed command exited if it broke a sandbox rule. Only the signal it
+// was stopped with is trusted, never what it printed or exited with, since the student code controls that
+func classifyExit(status exitStatus) string {
+	if !status.signaled {
+		return SandboxOutcomeFailed
+	}
+
+	switch status.signal {
+	case syscall.SIGXCPU:
+		return SandboxOutcomeCPULimit
+	case syscall.SIGXFSZ:
+		return SandboxOutcomeFileSizeLimit
+	case syscall.SIGKILL, syscall.SIGABRT:
+		// Nothing in the sandbox sends SIGKILL but the kernel running out of memory, as the timeout was
+		// already checked, and node aborts when it cannot grow its heap past the address space limit
+		return SandboxOutcomeMemoryLimit
+	}
+	return SandboxOutcomeFailed
+}
+
+func init() {
+	if fd := os.Getenv(sandboxReaperFDEnv); fd != "" {
+		os.Exit(runSandboxReaper(fd, os.Args[1:]))
+	}
+}
+
+// runSandboxReaper
+// Runs the command given to the grader inside the sandbox and writes how it exited to the status pipe,
+// as "signaled <signal>" or "exited <code>". The pipe is closed on exec and the reaper made
+// not dumpable, so the command can neither write to it nor reach it through /proc or ptrace
+func runSandboxReaper(fdText string, command []string) int {
+	const commandNotRun = 127
+	fd, err := strconv.Atoi(fdText)
+	if err != nil || len(command) == 0 {
+		fmt.Fprintf(os.Stderr, "sandbox reaper: no command or status file descriptor given\n")
+		return commandNotRun
+	}
+	syscall.CloseOnExec(fd)
+	_ = os.Unsetenv(sandboxReaperFDEnv)
+	if _, _, errno := syscall.RawSyscall(syscall.SYS_PRCTL, syscall.PR_SET_DUMPABLE, 0, 0); errno != 0 {
+		fmt.Fprintf(os.Stderr, "sandbox reaper: %s\n", errno)
+		return commandNotRun
+	}
+	statusWriter := os.NewFile(uintptr(fd), "sandbox-status")
+	defer statusWriter.Close()
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err = cmd.Run()
+
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		fmt.Fprintf(os.Stderr, "sandbox reaper: %s\n", err)
+		fmt.Fprintf(statusWriter, "exited %d\n", commandNotRun)
+		return commandNotRun
+	}
+	if exitErr != nil {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			fmt.Fprintf(statusWriter, "signaled %d\n", int(status.Signal()))
+			return 128 + int(status.Signal())
+		}
+		fmt.Fprintf(statusWriter, "exited %d\n", exitErr.ExitCode())
+		return exitErr.ExitCode()
+	}
+	fmt.Fprintf(statusWriter, "exited 0\n")
+	return 0
+}
+
+// tailBuffer
+// Keeps only the last limit bytes written to it
+type tailBuffer struct {
+	buffer bytes.Buffer
+	limit  int
+}
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	t.buffer.Write(p)
+	if extra := t.buffer.Len() - t.limit; extra > 0 {
+		t.buffer.Next(extra)
+	}
+	return len(p), nil
+}
+
+func (t *tailBuffer) String() string {
+	return t.buffer.String()
+}
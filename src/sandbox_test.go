@@ -0,0 +1,136 @@
+package graderFactory
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSandboxOutcomes(t *testing.T) {
+	tests := []struct {
+		name     string
+		script   string
+		outcome  string
+		exitCode int
+	}{
+		{name: "success", script: "exit 0", outcome: SandboxOutcomeOK, exitCode: 0},
+		{name: "failing tests", script: "exit 1", outcome: SandboxOutcomeFailed, exitCode: 1},
+		{name: "cpu limit", script: "kill -XCPU $$", outcome: SandboxOutcomeCPULimit, exitCode: -1},
+		{name: "file size limit", script: "kill -XFSZ $$", outcome: SandboxOutcomeFileSizeLimit, exitCode: -1},
+		{name: "exiting with 128 + a signal is not a violation", script: "exit 153", outcome: SandboxOutcomeFailed, exitCode: 153},
+		{name: "killed for memory", script: "kill -KILL $$", outcome: SandboxOutcomeMemoryLimit, exitCode: -1},
+		{name: "other signal", script: "kill -TERM $$", outcome: SandboxOutcomeFailed, exitCode: -1},
+		{name: "printing about memory is not a violation", script: "echo 'JavaScript heap out of memory'; exit 1", outcome: SandboxOutcomeFailed, exitCode: 1},
+	}
+
+	runner := &sandboxRunner{disabled: true}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := runner.Run(context.Background(), t.TempDir(), nil, false, "sh", "-c", test.script)
+			if result.Outcome != test.outcome {
+				t.Errorf("outcome = %s, want %s (%v)", result.Outcome, test.outcome, result.Err)
+			}
+			if result.ExitCode != test.exitCode {
+				t.Errorf("exit code = %d, want %d", result.ExitCode, test.exitCode)
+			}
+		})
+	}
+}
+
+func TestSandboxTimeoutKillsChildren(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	runner := &sandboxRunner{disabled: true}
+	result := runner.Run(ctx, t.TempDir(), nil, false, "sh", "-c", "sleep 30 & sleep 30")
+	if result.Outcome != SandboxOutcomeTimeout {
+		t.Errorf("outcome = %s, want %s", result.Outcome, SandboxOutcomeTimeout)
+	}
+	if elapsed := time.Since(start); elapsed > sandboxWaitDelay {
+		t.Errorf("took %s to return, the children kept running", elapsed)
+	}
+}
+
+func TestSandboxReaperReportsHowTheCommandExited(t *testing.T) {
+	executable, err := os.Executable()
+	if err != nil {
+		t.Skipf("test binary can not be run as the reaper: %s", err)
+	}
+	tests := []struct {
+		script string
+		report string
+		code   int
+	}{
+		{script: "exit 0", report: "exited 0", code: 0},
+		{script: "exit 153", report: "exited 153", code: 153},
+		{script: "kill -XCPU $$", report: "signaled 24", code: 128 + 24},
+	}
+
+	for _, test := range tests {
+		t.Run(test.script, func(t *testing.T) {
+			statusReader, statusWriter, err := os.Pipe()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer statusReader.Close()
+
+			cmd := exec.Command(executable, "sh", "-c", test.script)
+			cmd.Env = append(os.Environ(), sandboxReaperFDEnv+"="+strconv.Itoa(sandboxReaperFD))
+			cmd.ExtraFiles = []*os.File{statusWriter}
+			err = cmd.Start()
+			statusWriter.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			_ = cmd.Wait()
+
+			report, _ := bufio.NewReader(statusReader).ReadString('\n')
+			if strings.TrimSpace(report) != test.report {
+				t.Errorf("report = %q, want %q", report, test.report)
+			}
+			if cmd.ProcessState.ExitCode() != test.code {
+				t.Errorf("exit code = %d, want %d", cmd.ProcessState.ExitCode(), test.code)
+			}
+		})
+	}
+}
+
+func TestSandboxOutcomesInBubblewrap(t *testing.T) {
+	runner := newSandboxRunner()
+	runner.disabled = false
+	if runner.lookupFailed != nil {
+		t.Skipf("bwrap and prlimit are needed: %s", runner.lookupFailed)
+	}
+	if result := runner.Run(context.Background(), t.TempDir(), nil, false, "sh", "-c", "exit 0"); result.Outcome != SandboxOutcomeOK {
+		t.Skipf("bwrap can not create a sandbox here: %v %s", result.Err, result.Output)
+	}
+
+	tests := []struct {
+		script   string
+		outcome  string
+		exitCode int
+	}{
+		{script: "exit 1", outcome: SandboxOutcomeFailed, exitCode: 1},
+		{script: "exit 153", outcome: SandboxOutcomeFailed, exitCode: 153},
+		{script: "exit 137", outcome: SandboxOutcomeFailed, exitCode: 137},
+		{script: "kill -XFSZ $$", outcome: SandboxOutcomeFileSizeLimit, exitCode: -1},
+		{script: "kill -KILL $$", outcome: SandboxOutcomeMemoryLimit, exitCode: -1},
+	}
+	for _, test := range tests {
+		t.Run(test.script, func(t *testing.T) {
+			result := runner.Run(context.Background(), t.TempDir(), nil, false, "sh", "-c", test.script)
+			if result.Outcome != test.outcome {
+				t.Errorf("outcome = %s, want %s (%v)", result.Outcome, test.outcome, result.Err)
+			}
+			if result.ExitCode != test.exitCode {
+				t.Errorf("exit code = %d, want %d", result.ExitCode, test.exitCode)
+			}
+		})
+	}
+}
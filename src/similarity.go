@@ -0,0 +1,319 @@
+package complexCommons
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"os"
+	"sort"
+)
+
+// Winnowing (Schleimer, Wilkerson and Aiken, the algorithm behind MOSS) hashes every
+// run of KGramSize tokens and keeps the smallest hash of every WindowSize hashes in a row.
+// Any copied run of at least KGramSize+WindowSize-1 tokens is then sure to share a fingerprint,
+// while runs shorter than KGramSize never match
+const (
+	KGramSize  = 12
+	WindowSize = 8
+)
+
+// NormalizedToken
+// A token with names and values taken out, so renaming a variable or
+// changing a string does not hide copied code
+type NormalizedToken struct {
+	Text     string
+	Original string // the text as it was written, for telling exact copies from renamed ones
+	Line     int
+}
+
+// Fingerprint
+// The hash of a run of tokens and where in the file that run is
+type Fingerprint struct {
+	Hash      uint64
+	FileName  string
+	StartLine int
+	EndLine   int
+}
+
+// SubmissionFingerprints
+// The fingerprints of every file of one submission.
+// Semester is set on the submissions kept in the archive of past semesters
+type SubmissionFingerprints struct {
+	Name         string
+	Semester     string
+	Fingerprints []Fingerprint
+}
+
+// MatchedRegion
+// Lines of the two submissions that share fingerprints
+type MatchedRegion struct {
+	FirstFile       string
+	FirstStartLine  int
+	FirstEndLine    int
+	SecondFile      string
+	SecondStartLine int
+	SecondEndLine   int
+	Matches         int // pairs of fingerprints joined into this region
+}
+
+// SimilarityPair
+// How much of each submission is found in the other.
+// FirstSimilarity is the part of the fingerprints of First that are shared, from 0 to 1
+type SimilarityPair struct {
+	First            string
+	FirstSemester    string
+	Second           string
+	SecondSemester   string
+	SharedHashes     int
+	FirstSimilarity  float64
+	SecondSimilarity float64
+	Regions          []MatchedRegion
+}
+
+// GetSimilarity
+// The larger of the two similarities, which is what pairs are ranked by,
+// as a short file copied whole into a long one is still copied
+func (p SimilarityPair) GetSimilarity() float64 {
+	if p.FirstSimilarity > p.SecondSimilarity {
+		return p.FirstSimilarity
+	}
+	return p.SecondSimilarity
+}
+
+// kGram
+// The hash of the run of tokens starting at a token
+type kGram struct {
+	hash      uint64
+	startLine int
+	endLine   int
+}
+
+func hashKGrams(tokens []NormalizedToken) []kGram {
+	if len(tokens) < KGramSize {
+		return []kGram{}
+	}
+	kGrams := make([]kGram, 0, len(tokens)-KGramSize+1)
+	for i := 0; i+KGramSize <= len(tokens); i++ {
+		hasher := fnv.New64a()
+		for _, token := range tokens[i : i+KGramSize] {
+			hasher.Write([]byte(token.Text))
+			hasher.Write([]byte{0})
+		}
+		kGrams = append(kGrams, kGram{hash: hasher.Sum64(), startLine: tokens[i].Line, endLine: tokens[i+KGramSize-1].Line})
+	}
+	return kGrams
+}
+
+// FingerprintFile
+// Winnows the hashes of the tokens of a file. In each window the rightmost smallest hash is kept,
+// and a hash is only kept once even though it is the smallest of several windows
+func FingerprintFile(fileName string, tokens []NormalizedToken) []Fingerprint {
+	kGrams := hashKGrams(tokens)
+	fingerprints := []Fingerprint{}
+	if len(kGrams) == 0 {
+		return fingerprints
+	}
+
+	window := WindowSize
+	if len(kGrams) < window {
+		window = len(kGrams)
+	}
+	lastChosen := -1
+	for start := 0; start+window <= len(kGrams); start++ {
+		chosen := start
+		for i := start + 1; i < start+window; i++ {
+			if kGrams[i].hash <= kGrams[chosen].hash {
+				chosen = i
+			}
+		}
+		if chosen == lastChosen {
+			continue
+		}
+		lastChosen = chosen
+		fingerprints = append(fingerprints, Fingerprint{
+			Hash:      kGrams[chosen].hash,
+			FileName:  fileName,
+			StartLine: kGrams[chosen].startLine,
+			EndLine:   kGrams[chosen].endLine,
+		})
+	}
+	return fingerprints
+}
+
+// GetTemplateHashes
+// Every hash of the starter template, not just the winnowed ones, since a submission
+// winnows the same code differently once it has code of its own around it
+func GetTemplateHashes(tokens []NormalizedToken) map[uint64]bool {
+	hashes := map[uint64]bool{}
+	for _, gram := range hashKGrams(tokens) {
+		hashes[gram.hash] = true
+	}
+	return hashes
+}
+
+// RemoveTemplateFingerprints
+// Leaves out the fingerprints that come from the starter template, so shared scaffolding is not matched
+func RemoveTemplateFingerprints(fingerprints []Fingerprint, template map[uint64]bool) []Fingerprint {
+	kept := []Fingerprint{}
+	for _, fingerprint := range fingerprints {
+		if !template[fingerprint.Hash] {
+			kept = append(kept, fingerprint)
+		}
+	}
+	return kept
+}
+
+// CompareSubmissions
+// Compares every submission with every other one and with every archived submission,
+// returning the pairs sharing at least minimumShared fingerprints from most to least similar.
+// Archived submissions are not compared with each other, as they were when they were graded
+func CompareSubmissions(current []SubmissionFingerprints, archive []SubmissionFingerprints, minimumShared int) []SimilarityPair {
+	all := append(append([]SubmissionFingerprints{}, current...), archive...)
+	uniqueHashes := make([]map[uint64][]Fingerprint, len(all))
+	owners := map[uint64][]int{}
+	for i, submission := range all {
+		uniqueHashes[i] = map[uint64][]Fingerprint{}
+		for _, fingerprint := range submission.Fingerprints {
+			if len(uniqueHashes[i][fingerprint.Hash]) == 0 {
+				owners[fingerprint.Hash] = append(owners[fingerprint.Hash], i)
+			}
+			uniqueHashes[i][fingerprint.Hash] = append(uniqueHashes[i][fingerprint.Hash], fingerprint)
+		}
+	}
+
+	// Counting shared hashes through who owns each hash only looks at submissions that share something
+	shared := map[[2]int]int{}
+	for _, submissions := range owners {
+		for a := 0; a < len(submissions); a++ {
+			for b := a + 1; b < len(submissions); b++ {
+				first, second := submissions[a], submissions[b]
+				if first >= len(current) && second >= len(current) {
+					continue
+				}
+				shared[[2]int{first, second}]++
+			}
+		}
+	}
+
+	var pairs []SimilarityPair
+	for key, count := range shared {
+		if count < minimumShared {
+			continue
+		}
+		first, second := all[key[0]], all[key[1]]
+		pairs = append(pairs, SimilarityPair{
+			First:            first.Name,
+			FirstSemester:    first.Semester,
+			Second:           second.Name,
+			SecondSemester:   second.Semester,
+			SharedHashes:     count,
+			FirstSimilarity:  float64(count) / float64(len(uniqueHashes[key[0]])),
+			SecondSimilarity: float64(count) / float64(len(uniqueHashes[key[1]])),
+			Regions:          getMatchedRegions(uniqueHashes[key[0]], uniqueHashes[key[1]]),
+		})
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].GetSimilarity() != pairs[j].GetSimilarity() {
+			return pairs[i].GetSimilarity() > pairs[j].GetSimilarity()
+		}
+		if pairs[i].First != pairs[j].First {
+			return pairs[i].First < pairs[j].First
+		}
+		return pairs[i].Second < pairs[j].Second
+	})
+	return pairs
+}
+
+// getMatchedRegions
+// Pairs up the lines of every shared fingerprint and joins pairs that touch in both submissions
+func getMatchedRegions(first map[uint64][]Fingerprint, second map[uint64][]Fingerprint) []MatchedRegion {
+	var regions []MatchedRegion
+	for hash, firstFingerprints := range first {
+		for _, a := range firstFingerprints {
+			for _, b := range second[hash] {
+				regions = append(regions, MatchedRegion{
+					FirstFile:       a.FileName,
+					FirstStartLine:  a.StartLine,
+					FirstEndLine:    a.EndLine,
+					SecondFile:      b.FileName,
+					SecondStartLine: b.StartLine,
+					SecondEndLine:   b.EndLine,
+					Matches:         1,
+				})
+			}
+		}
+	}
+
+	sort.Slice(regions, func(i, j int) bool {
+		a, b := regions[i], regions[j]
+		if a.FirstFile != b.FirstFile {
+			return a.FirstFile < b.FirstFile
+		}
+		if a.SecondFile != b.SecondFile {
+			return a.SecondFile < b.SecondFile
+		}
+		if a.FirstStartLine != b.FirstStartLine {
+			return a.FirstStartLine < b.FirstStartLine
+		}
+		return a.SecondStartLine < b.SecondStartLine
+	})
+
+	var merged []MatchedRegion
+	for _, region := range regions {
+		if len(merged) > 0 {
+			last := &merged[len(merged)-1]
+			if last.FirstFile == region.FirstFile && last.SecondFile == region.SecondFile &&
+				region.FirstStartLine <= last.FirstEndLine+1 &&
+				region.SecondStartLine <= last.SecondEndLine+1 && region.SecondEndLine+1 >= last.SecondStartLine {
+				last.FirstEndLine = maxInt(last.FirstEndLine, region.FirstEndLine)
+				last.SecondStartLine = minInt(last.SecondStartLine, region.SecondStartLine)
+				last.SecondEndLine = maxInt(last.SecondEndLine, region.SecondEndLine)
+				last.Matches++
+				continue
+			}
+		}
+		merged = append(merged, region)
+	}
+	return merged
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// LoadFingerprintArchive
+// Reads the fingerprints of past semesters. A missing archive is the same as an empty one
+func LoadFingerprintArchive(location string) ([]SubmissionFingerprints, error) {
+	content, err := os.ReadFile(location)
+	if os.IsNotExist(err) {
+		return []SubmissionFingerprints{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var archive []SubmissionFingerprints
+	err = json.Unmarshal(content, &archive)
+	return archive, err
+}
+
+// SaveFingerprintArchive
+// Writes the fingerprints so later semesters can be compared with them
+func SaveFingerprintArchive(location string, archive []SubmissionFingerprints) error {
+	content, err := json.Marshal(archive)
+	if err != nil {
+		return err
+	}
+	const permission = 0777
+	return os.WriteFile(location, content, permission)
+}
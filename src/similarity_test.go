@@ -0,0 +1,177 @@
+package complexCommons
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+)
+
+// testTokens
+// Makes one token per line out of each text, which is used as both the normalised and the original text
+func testTokens(texts ...string) []NormalizedToken {
+	tokens := make([]NormalizedToken, 0, len(texts))
+	for i, text := range texts {
+		tokens = append(tokens, NormalizedToken{Text: text, Original: text, Line: i + 1})
+	}
+	return tokens
+}
+
+// numberedTokens
+// Makes count distinct tokens starting from prefix0, so no run of them repeats
+func numberedTokens(prefix string, count int) []string {
+	texts := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		texts = append(texts, fmt.Sprintf("%s%d", prefix, i))
+	}
+	return texts
+}
+
+func hashesOf(fingerprints []Fingerprint) map[uint64]bool {
+	hashes := map[uint64]bool{}
+	for _, fingerprint := range fingerprints {
+		hashes[fingerprint.Hash] = true
+	}
+	return hashes
+}
+
+func TestFingerprintFile(t *testing.T) {
+	if fingerprints := FingerprintFile("short.ts", testTokens(numberedTokens("t", KGramSize-1)...)); len(fingerprints) != 0 {
+		t.Errorf("a file shorter than a k-gram gave %d fingerprints, want none", len(fingerprints))
+	}
+
+	one := FingerprintFile("one.ts", testTokens(numberedTokens("t", KGramSize)...))
+	if len(one) != 1 || one[0].FileName != "one.ts" || one[0].StartLine != 1 || one[0].EndLine != KGramSize {
+		t.Errorf("a file of one k-gram gave %+v, want one fingerprint over lines 1-%d", one, KGramSize)
+	}
+
+	// Every window picks some k-gram, so there are never fewer fingerprints than windows divided by the window size
+	tokens := testTokens(numberedTokens("t", 200)...)
+	fingerprints := FingerprintFile("long.ts", tokens)
+	kGrams := len(tokens) - KGramSize + 1
+	if len(fingerprints) < (kGrams-WindowSize+1)/WindowSize || len(fingerprints) > kGrams {
+		t.Errorf("got %d fingerprints out of %d k-grams", len(fingerprints), kGrams)
+	}
+}
+
+func TestFingerprintFileFindsEveryLongCopy(t *testing.T) {
+	// A copied run of KGramSize+WindowSize-1 tokens always shares a fingerprint, wherever it is placed
+	copied := numberedTokens("copy", KGramSize+WindowSize-1)
+	for offset := 0; offset < 20; offset++ {
+		first := append(append(numberedTokens("a", offset), copied...), numberedTokens("b", 30)...)
+		second := append(append(numberedTokens("c", 30-offset), copied...), numberedTokens("d", offset)...)
+
+		firstHashes := hashesOf(FingerprintFile("first.ts", testTokens(first...)))
+		shared := false
+		for hash := range hashesOf(FingerprintFile("second.ts", testTokens(second...))) {
+			shared = shared || firstHashes[hash]
+		}
+		if !shared {
+			t.Errorf("copy placed after %d tokens shares no fingerprint", offset)
+		}
+	}
+}
+
+func TestFingerprintFileIgnoresShortCopies(t *testing.T) {
+	copied := numberedTokens("copy", KGramSize-1)
+	first := append(append(numberedTokens("a", 20), copied...), numberedTokens("b", 20)...)
+	second := append(append(numberedTokens("c", 20), copied...), numberedTokens("d", 20)...)
+
+	firstHashes := hashesOf(FingerprintFile("first.ts", testTokens(first...)))
+	for hash := range hashesOf(FingerprintFile("second.ts", testTokens(second...))) {
+		if firstHashes[hash] {
+			t.Fatal("a copy shorter than a k-gram shares a fingerprint")
+		}
+	}
+}
+
+func TestRemoveTemplateFingerprints(t *testing.T) {
+	template := numberedTokens("template", 40)
+	own := numberedTokens("own", 40)
+	fingerprints := FingerprintFile("stack.ts", testTokens(append(append([]string{}, template...), own...)...))
+
+	kept := RemoveTemplateFingerprints(fingerprints, GetTemplateHashes(testTokens(template...)))
+	if len(kept) == 0 || len(kept) >= len(fingerprints) {
+		t.Fatalf("kept %d of %d fingerprints, want only the ones of the code the student wrote", len(kept), len(fingerprints))
+	}
+	for _, fingerprint := range kept {
+		if fingerprint.StartLine <= len(template)-KGramSize+1 {
+			t.Errorf("kept a fingerprint of the template at lines %d-%d", fingerprint.StartLine, fingerprint.EndLine)
+		}
+	}
+}
+
+// testFingerprints
+// Makes one fingerprint per hash, each on its own line starting from firstLine
+func testFingerprints(fileName string, firstLine int, hashes ...uint64) []Fingerprint {
+	fingerprints := make([]Fingerprint, 0, len(hashes))
+	for i, hash := range hashes {
+		line := firstLine + i
+		fingerprints = append(fingerprints, Fingerprint{Hash: hash, FileName: fileName, StartLine: line, EndLine: line})
+	}
+	return fingerprints
+}
+
+func TestCompareSubmissions(t *testing.T) {
+	current := []SubmissionFingerprints{
+		{Name: "alice", Fingerprints: testFingerprints("stack.ts", 1, 1, 2, 3, 4, 10, 11)},
+		{Name: "bob", Fingerprints: testFingerprints("stack.ts", 5, 1, 2, 3, 4)},
+		{Name: "carol", Fingerprints: testFingerprints("stack.ts", 1, 20, 21)},
+	}
+	archive := []SubmissionFingerprints{
+		{Name: "dave", Semester: "fall", Fingerprints: testFingerprints("stack.ts", 1, 1, 2, 30)},
+		{Name: "erin", Semester: "fall", Fingerprints: testFingerprints("stack.ts", 1, 1, 2, 30)},
+	}
+
+	tests := []struct {
+		name          string
+		minimumShared int
+		want          []string
+	}{
+		{
+			name:          "every pair sharing something",
+			minimumShared: 1,
+			// bob is all found in alice, the rest share two thirds of dave or erin and ties go by name
+			want: []string{"alice/bob 1.00", "alice/dave 0.67", "alice/erin 0.67", "bob/dave 0.67", "bob/erin 0.67"},
+		},
+		{name: "pairs sharing too little are left out", minimumShared: 3, want: []string{"alice/bob 1.00"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pairs := CompareSubmissions(current, archive, test.minimumShared)
+			got := make([]string, 0, len(pairs))
+			for _, pair := range pairs {
+				got = append(got, fmt.Sprintf("%s/%s %.2f", pair.First, pair.Second, pair.GetSimilarity()))
+			}
+			if fmt.Sprint(got) != fmt.Sprint(test.want) {
+				t.Errorf("pairs = %v, want %v", got, test.want)
+			}
+		})
+	}
+
+	pairs := CompareSubmissions(current, archive, 1)
+	if pairs[1].SecondSemester != "fall" || pairs[0].SecondSemester != "" {
+		t.Errorf("semesters = %q and %q, want only the archived one set", pairs[0].SecondSemester, pairs[1].SecondSemester)
+	}
+	want := MatchedRegion{FirstFile: "stack.ts", FirstStartLine: 1, FirstEndLine: 4, SecondFile: "stack.ts", SecondStartLine: 5, SecondEndLine: 8, Matches: 4}
+	if len(pairs[0].Regions) != 1 || pairs[0].Regions[0] != want {
+		t.Errorf("regions = %+v, want the touching matches joined into %+v", pairs[0].Regions, want)
+	}
+}
+
+func TestFingerprintArchive(t *testing.T) {
+	location := filepath.Join(t.TempDir(), "archive.json")
+	archive, err := LoadFingerprintArchive(location)
+	if err != nil || len(archive) != 0 {
+		t.Fatalf("missing archive = %v, %v, want an empty archive", archive, err)
+	}
+
+	saved := []SubmissionFingerprints{{Name: "alice", Semester: "fall", Fingerprints: []Fingerprint{{Hash: 42, FileName: "stack.ts", StartLine: 1, EndLine: 3}}}}
+	if err := SaveFingerprintArchive(location, saved); err != nil {
+		t.Fatal(err)
+	}
+	archive, err = LoadFingerprintArchive(location)
+	if err != nil || len(archive) != 1 || archive[0].Name != "alice" || archive[0].Fingerprints[0] != saved[0].Fingerprints[0] {
+		t.Errorf("archive = %v, %v, want %v", archive, err, saved)
+	}
+}
@@ -0,0 +1,137 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/common"
+	"SubmissionGrader/internal/complexity/complexCommons"
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+	"SubmissionGrader/internal/complexity/typescript"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const complexityReportJSON = "/complexity-report.json"
+
+// ParseTypescriptSubmission
+// Parses every TypeScript and JavaScript file under root once, naming each file by its path relative to root.
+// The complexity report, the dependency and call graphs and the dead code are all built from what it gives
+func ParseTypescriptSubmission(root string) ([]complexCommons.FileParseResult, error) {
+	parser, ok := typescript.CreateTypescriptComplexityParser().(complexCommons.FileParser)
+	if !ok {
+		return nil, fmt.Errorf("typescript parser cannot parse whole files")
+	}
+	paths, err := getTypescriptSourceFiles(root)
+	if err != nil {
+		return nil, err
+	}
+
+	analyzer := complexCommons.NewComplexityAnalyzer(parser, runtime.NumCPU(), getComplexityCacheDirectory())
+	analyzed := analyzer.AnalyzeFiles(paths, false)
+	files := make([]complexCommons.FileParseResult, 0, len(analyzed))
+	for i, file := range analyzed {
+		relative, err := filepath.Rel(root, paths[i])
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file.Result.WithFileName(filepath.ToSlash(relative)))
+	}
+	stats := analyzer.GetCacheStats()
+	common.Debug(fmt.Sprintf("Parsed %d files, %d found in the cache, %d could not be read", stats.Files, stats.Hits, stats.Failed))
+	return files, nil
+}
+
+// getComplexityCacheDirectory
+// Where parsed files are cached between submissions, which can be changed with COMPLEXITY_CACHE_DIRECTORY.
+// It is kept in the cache directory of the user the grader runs as rather than the shared temporary directory.
+// COMPLEXITY_CACHE_DIRECTORY=off turns the cache off
+func getComplexityCacheDirectory() string {
+	directory := os.Getenv("COMPLEXITY_CACHE_DIRECTORY")
+	switch directory {
+	case "":
+		userCache, err := os.UserCacheDir()
+		if err != nil {
+			common.Warning(fmt.Sprintf("Not caching parsed files, there is no cache directory: %s", err))
+			return ""
+		}
+		return filepath.Join(userCache, "SubmissionGrader", "complexity")
+	case "off":
+		return ""
+	}
+	return directory
+}
+
+// ComplexityReport
+// The complexity of every file the student wrote, the design metrics of its classes
+// and the methods copied between them, tests left out
+type ComplexityReport struct {
+	Files   []complexCommons.FileComplexityReport
+	Classes []complexCommons.ClassMetrics
+	Clones  complexCommons.CloneReport
+}
+
+// GetMethods
+// Returns the methods of every file of the report
+func (r ComplexityReport) GetMethods() []methodInfoType.MethodInfo {
+	var methods []methodInfoType.MethodInfo
+	for _, file := range r.Files {
+		methods = append(methods, file.Methods...)
+	}
+	return methods
+}
+
+// BuildTypescriptComplexityReport
+// Rolls the parsed files the student wrote up into their complexity reports,
+// and works out the metrics of their classes and the clones of their methods across all of the files.
+// Tests are left out, they are not what the complexity of a submission is judged on
+func BuildTypescriptComplexityReport(files []complexCommons.FileParseResult) ComplexityReport {
+	report := ComplexityReport{Files: []complexCommons.FileComplexityReport{}}
+	var classes []complexCommons.ClassInfo
+	for _, file := range files {
+		if isTestFile(file.FileName) {
+			continue
+		}
+		report.Files = append(report.Files, file.GetComplexityReport())
+		classes = append(classes, file.Classes...)
+	}
+	report.Classes = complexCommons.ComputeClassMetrics(classes)
+	report.Clones = DetectTypescriptClones(files)
+	return report
+}
+
+// writeComplexityReport
+// Writes the report as indented JSON next to the submission
+func writeComplexityReport(directory string, report ComplexityReport) error {
+	content, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	const permission = 0777
+	return os.WriteFile(directory+complexityReportJSON, content, permission)
+}
+
+// logComplexityReport
+// Reports the summary of every file, the metrics of every class and the clones, and the files that could not be parsed
+func logComplexityReport(report ComplexityReport) {
+	for _, file := range report.Files {
+		if file.ParseStatus != complexCommons.ParseStatusParsed {
+			common.Warning(fmt.Sprintf("Complexity of %s may be incomplete, parsing it gave %s", file.FileName, file.ParseStatus))
+		}
+		summary := file.Summary
+		common.Debug(fmt.Sprintf("%s: %d methods, cyclomatic %d, cognitive %d, max nesting %d, %d lines of code, maintainability %.1f",
+			file.FileName, summary.MethodCount, summary.TotalCycCount, summary.TotalCogCount, summary.MaxNesting, summary.SLOC, summary.MaintainabilityIndex))
+	}
+	for _, class := range report.Classes {
+		common.Debug(fmt.Sprintf("%s (%s): WMC %d, DIT %d, NOC %d, LCOM %d, CBO %d",
+			class.Name, class.FileName, class.WMC, class.DIT, class.NOC, class.LCOM, class.CBO))
+	}
+	for _, group := range report.Clones.Groups {
+		members := make([]string, 0, len(group.Members))
+		for _, member := range group.Members {
+			members = append(members, fmt.Sprintf("%s (%s:%d)", member.MethodName, member.FileName, member.StartLine))
+		}
+		common.Debug(fmt.Sprintf("Type %d clones: %s", group.Type, strings.Join(members, ", ")))
+	}
+}
@@ -0,0 +1,153 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/complexity/complexCommons"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeSubmission
+// Writes every file (its path relative to the submission mapped to its content) under a new directory
+func writeSubmission(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestBuildTypescriptComplexityReport(t *testing.T) {
+	root := writeSubmission(t, map[string]string{
+		"src/shape.ts": `export class Shape {
+  protected name = 'shape';
+  area(): number {
+    return 0;
+  }
+}
+`,
+		"src/square.ts": `import { Shape } from './shape';
+
+export class Square extends Shape {
+  private side = 1;
+  area(): number {
+    return this.side > 0 ? this.side * this.side : 0;
+  }
+}
+`,
+		"src/square.test.ts": `import { Square } from './square';
+
+class FakeSquare extends Square {}
+test('area', () => expect(new Square().area()).toBe(1));
+`,
+	})
+
+	files, err := ParseTypescriptSubmission(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := BuildTypescriptComplexityReport(files)
+
+	if len(report.Files) != 2 || report.Files[0].FileName != "src/shape.ts" || report.Files[1].FileName != "src/square.ts" {
+		t.Fatalf("files = %v, want src/shape.ts and src/square.ts", report.Files)
+	}
+	if len(report.GetMethods()) != 2 {
+		t.Errorf("found %d methods, want 2", len(report.GetMethods()))
+	}
+
+	tests := []struct {
+		name     string
+		fileName string
+		wmc      int
+		dit      int
+		noc      int
+		cbo      int
+	}{
+		{name: "Shape", fileName: "src/shape.ts", wmc: 1, dit: 0, noc: 1, cbo: 1},
+		{name: "Square", fileName: "src/square.ts", wmc: 2, dit: 1, noc: 0, cbo: 1},
+	}
+	if len(report.Classes) != len(tests) {
+		t.Fatalf("classes = %v, want %d classes", report.Classes, len(tests))
+	}
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := report.Classes[i]
+			if class.Name != test.name || class.FileName != test.fileName {
+				t.Fatalf("class = %s in %s, want %s in %s", class.Name, class.FileName, test.name, test.fileName)
+			}
+			if class.WMC != test.wmc || class.DIT != test.dit || class.NOC != test.noc || class.CBO != test.cbo {
+				t.Errorf("WMC %d, DIT %d, NOC %d, CBO %d, want %d, %d, %d, %d",
+					class.WMC, class.DIT, class.NOC, class.CBO, test.wmc, test.dit, test.noc, test.cbo)
+			}
+		})
+	}
+}
+
+func TestDuplicationOfTypescriptSubmission(t *testing.T) {
+	method := `export function %s(items: number[]): number {
+  let total = 0;
+  for (let i = 0; i < items.length; i++) {
+    if (items[i] > %d) {
+      total += items[i] * 2;
+    }
+  }
+  return total;
+}
+`
+	root := writeSubmission(t, map[string]string{
+		"src/sum.ts":          fmt.Sprintf(method, "sumLarge", 10),
+		"src/copy.ts":         fmt.Sprintf(method, "sumBig", 20),
+		"src/other.ts":        "export function double(x: number): number {\n  return x * 2;\n}\n",
+		"src/sum.test.ts":     fmt.Sprintf(method, "sumInTest", 10),
+		"src/test/helpers.ts": fmt.Sprintf(method, "sumHelper", 10),
+		"src/__tests__/a.ts":  fmt.Sprintf(method, "sumFixture", 10),
+	})
+
+	files, err := ParseTypescriptSubmission(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clones := BuildTypescriptComplexityReport(files).Clones
+	if len(clones.Groups) != 1 || len(clones.Groups[0].Members) != 2 {
+		t.Fatalf("groups = %+v, want the two renamed copies outside the tests", clones.Groups)
+	}
+	group := clones.Groups[0]
+	if group.Type != complexCommons.CloneTypeRenamed || group.Members[0].FileName != "src/copy.ts" || group.Members[1].FileName != "src/sum.ts" {
+		t.Errorf("group = %+v, want a renamed clone in src/copy.ts and src/sum.ts", group)
+	}
+	// One copy is duplicated, out of both copies and double
+	copyTokens := group.Members[0].TokenCount
+	if clones.DuplicatedTokens != copyTokens || clones.TotalTokens <= 2*copyTokens || clones.TotalTokens >= 3*copyTokens {
+		t.Errorf("%d of %d tokens duplicated, want %d of the tokens of two copies and double", clones.DuplicatedTokens, clones.TotalTokens, copyTokens)
+	}
+
+	rubric := loadTestRubric(t, `{"duplicationLimit": {"maxPercentage": 30, "deduction": 5}}`)
+	grader := typescriptGrader{rubric: &rubric, rubricLoaded: true, TeacherRubricScore: RubricScore{Total: 20, Possible: 20}}
+	grader.ApplyDuplicationLimit(clones)
+	teacher, _ := grader.GetRubricScores()
+	if teacher.Total != 15 || teacher.DuplicationPercentage != clones.DuplicationPercentage {
+		t.Errorf("teacher score = %.2f with %.2f%% duplicated, want 15 with %.2f%%", teacher.Total, teacher.DuplicationPercentage, clones.DuplicationPercentage)
+	}
+}
+
+func TestAnalyzeSubmissionThatCannotBeParsed(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing")
+	grader := typescriptGrader{rubricLoaded: true, grader: graderStruct{data: graderData{assignmentRootPath: missing}}}
+	grader.analyzeSubmission()
+	want := []string{analysisComplexity, analysisImports, analysisDeadCode}
+	if !reflect.DeepEqual(grader.GetUnavailableAnalyses(), want) {
+		t.Errorf("unavailable = %v, want %v", grader.GetUnavailableAnalyses(), want)
+	}
+	if err := grader.AnalyzeComplexity(); err == nil {
+		t.Error("complexity was analyzed for a submission that could not be parsed")
+	}
+}
@@ -0,0 +1,87 @@
+package parserTypes
+
+import (
+	"encoding/xml"
+	"os"
+)
+
+// OutcomeTimeout
+// The outcome of the tests that were still running when the grader ran out of time.
+// They are written as an error with TimeoutErrorType as its type so they can be told apart from other errors
+const (
+	OutcomeTimeout   = "TIMEOUT"
+	TimeoutErrorType = "timeout"
+)
+
+// TestOutcome
+// The bare result of one test, as read from the output of a test runner
+// that was stopped before it could write its report
+type TestOutcome struct {
+	ClassName string
+	Name      string
+	Outcome   string
+}
+
+type junitReport struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string       `xml:"classname,attr"`
+	Name      string       `xml:"name,attr"`
+	Failure   *junitStatus `xml:"failure,omitempty"`
+	Skipped   *junitStatus `xml:"skipped,omitempty"`
+	Error     *junitStatus `xml:"error,omitempty"`
+}
+
+type junitStatus struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+}
+
+// WriteTimeoutReport
+// When the tests are stopped for taking too long the test runner never writes its report.
+// This writes a JUnit report holding the tests that did finish, plus one TIMEOUT test
+// standing in for the ones that did not, so it can be read like any other report
+func WriteTimeoutReport(location string, suiteName string, finished []TestOutcome, message string) error {
+	suite := junitSuite{Name: suiteName}
+	for _, outcome := range finished {
+		testCase := junitTestCase{ClassName: outcome.ClassName, Name: outcome.Name}
+		switch outcome.Outcome {
+		case "FAILED":
+			testCase.Failure = &junitStatus{}
+			suite.Failures++
+		case "SKIPPED":
+			testCase.Skipped = &junitStatus{}
+			suite.Skipped++
+		case "ERRORED":
+			testCase.Error = &junitStatus{}
+			suite.Errors++
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	suite.TestCases = append(suite.TestCases, junitTestCase{
+		ClassName: suiteName,
+		Name:      "Tests that did not finish in time",
+		Error:     &junitStatus{Message: message, Type: TimeoutErrorType},
+	})
+	suite.Errors++
+	suite.Tests = len(suite.TestCases)
+
+	content, err := xml.MarshalIndent(junitReport{Suites: []junitSuite{suite}}, "", "  ")
+	if err != nil {
+		return err
+	}
+	const permission = 0777
+	return os.WriteFile(location, append([]byte(xml.Header), content...), permission)
+}
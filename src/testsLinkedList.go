@@ -0,0 +1,43 @@
+package list
+
+// UnitTest
+// The result of one test case as read from a result file
+type UnitTest struct {
+	Name    string
+	Outcome string // PASSED, FAILED, SKIPPED or ERRORED
+	Message string
+
+	Duration   float64 // seconds, from the time attribute
+	StackTrace string  // the body of the failure or error element
+	SystemOut  string
+	SystemErr  string
+}
+
+// TestNode
+// One test of a TestsLinkedList, with the class it was reported under
+type TestNode struct {
+	ClassName string
+	Test      UnitTest
+	Next      *TestNode
+}
+
+// TestsLinkedList
+// The tests of a test run in the order they were read
+type TestsLinkedList struct {
+	Head *TestNode
+	Tail *TestNode
+	Size int
+}
+
+// AddTest
+// Adds the test to the end of the list
+func (l *TestsLinkedList) AddTest(className string, test UnitTest) {
+	node := &TestNode{ClassName: className, Test: test}
+	if l.Head == nil {
+		l.Head = node
+	} else {
+		l.Tail.Next = node
+	}
+	l.Tail = node
+	l.Size++
+}
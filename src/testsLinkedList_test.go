@@ -0,0 +1,39 @@
+package list
+
+import "testing"
+
+func TestAddTest(t *testing.T) {
+	tests := TestsLinkedList{}
+	tests.AddTest("Stack", UnitTest{Name: "push", Outcome: "PASSED", Duration: 0.5})
+	tests.AddTest("Stack", UnitTest{Name: "pop", Outcome: "FAILED", StackTrace: "Expected: 2"})
+	tests.AddTest("Queue", UnitTest{Name: "peek", Outcome: "SKIPPED", SystemOut: "out", SystemErr: "err"})
+
+	want := []TestNode{
+		{ClassName: "Stack", Test: UnitTest{Name: "push", Outcome: "PASSED", Duration: 0.5}},
+		{ClassName: "Stack", Test: UnitTest{Name: "pop", Outcome: "FAILED", StackTrace: "Expected: 2"}},
+		{ClassName: "Queue", Test: UnitTest{Name: "peek", Outcome: "SKIPPED", SystemOut: "out", SystemErr: "err"}},
+	}
+	if tests.Size != len(want) {
+		t.Errorf("size = %d, want %d", tests.Size, len(want))
+	}
+	i := 0
+	for node := tests.Head; node != nil; node = node.Next {
+		if i >= len(want) {
+			t.Fatalf("more than %d tests in the list", len(want))
+		}
+		if node.ClassName != want[i].ClassName || node.Test != want[i].Test {
+			t.Errorf("test %d = %s %+v, want %s %+v", i, node.ClassName, node.Test, want[i].ClassName, want[i].Test)
+		}
+		i++
+	}
+	if tests.Tail == nil || tests.Tail.Test.Name != "peek" {
+		t.Errorf("tail = %+v, want the last test added", tests.Tail)
+	}
+}
+
+func TestEmptyList(t *testing.T) {
+	tests := TestsLinkedList{}
+	if tests.Head != nil || tests.Tail != nil || tests.Size != 0 {
+		t.Errorf("empty list = %+v", tests)
+	}
+}
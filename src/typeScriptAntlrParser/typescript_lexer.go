@@ -4,8 +4,8 @@ package parser
 
 import (
 	"fmt"
-	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
-	//"github.com/antlr4-go/antlr/v4"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
 	"sync"
 	"unicode"
 )
@@ -16,7 +16,7 @@ var _ = sync.Once{}
 var _ = unicode.IsLetter
 
 type TypeScriptLexer struct {
-	*antlr.BaseLexer
+	TypeScriptLexerBase
 	channelNames []string
 	modeNames    []string
 	// TODO: EOF string
@@ -1175,25 +1175,3 @@ func (p *TypeScriptLexer) OctalIntegerLiteral_Sempred(localctx antlr.RuleContext
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
 	}
 }
-
-func (l *TypeScriptLexer) ProcessOpenBrace() {
-
-}
-
-func (l *TypeScriptLexer) ProcessCloseBrace() {
-
-}
-
-func (l *TypeScriptLexer) ProcessStringLiteral() {}
-
-func (l *TypeScriptLexer) IncreaseTemplateDepth() {}
-
-func (l *TypeScriptLexer) DecreaseTemplateDepth() {}
-
-func (l *TypeScriptLexer) StartTemplateString() {}
-
-func (l *TypeScriptLexer) IsRegexPossible() bool { return true }
-
-func (l *TypeScriptLexer) IsInTemplateString() bool { return true }
-
-func (l *TypeScriptLexer) IsStrictMode() bool { return true }
\ No newline at end of file
@@ -0,0 +1,115 @@
+package parser
+
+import (
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// TypeScriptLexerBase
+// The superClass of TypeScriptLexer.g4, keeping the state its actions and predicates need:
+// the last token, to tell a regular expression from a division, how deep we are in
+// template strings, to tell the } ending ${ from the one ending a block, and strict mode
+type TypeScriptLexerBase struct {
+	*antlr.BaseLexer
+
+	lastToken        antlr.Token
+	braceDepths      []int // open braces of the ${ expression of each template string we are in
+	scopeStrictModes []bool
+	useStrictCurrent bool
+}
+
+// NextToken
+// Remembers the last token that is not hidden before returning it
+func (l *TypeScriptLexerBase) NextToken() antlr.Token {
+	next := l.BaseLexer.NextToken()
+	if next.GetChannel() == antlr.TokenDefaultChannel {
+		l.lastToken = next
+	}
+	return next
+}
+
+// IsRegexPossible
+// A / starts a regular expression unless it follows something that has a value,
+// in which case it divides it
+func (l *TypeScriptLexerBase) IsRegexPossible() bool {
+	if l.lastToken == nil {
+		return true
+	}
+	switch l.lastToken.GetTokenType() {
+	case TypeScriptLexerIdentifier, TypeScriptLexerNullLiteral, TypeScriptLexerBooleanLiteral,
+		TypeScriptLexerThis, TypeScriptLexerCloseBracket, TypeScriptLexerCloseParen,
+		TypeScriptLexerOctalIntegerLiteral, TypeScriptLexerDecimalLiteral, TypeScriptLexerHexIntegerLiteral,
+		TypeScriptLexerStringLiteral, TypeScriptLexerPlusPlus, TypeScriptLexerMinusMinus:
+		return false
+	}
+	return true
+}
+
+func (l *TypeScriptLexerBase) IncreaseTemplateDepth() {
+	l.braceDepths = append(l.braceDepths, 0)
+}
+
+func (l *TypeScriptLexerBase) DecreaseTemplateDepth() {
+	if len(l.braceDepths) > 0 {
+		l.braceDepths = l.braceDepths[:len(l.braceDepths)-1]
+	}
+}
+
+// StartTemplateString
+// Called on the ${ of a template string, whose expression has no open braces yet
+func (l *TypeScriptLexerBase) StartTemplateString() {
+	if len(l.braceDepths) > 0 {
+		l.braceDepths[len(l.braceDepths)-1] = 0
+	}
+}
+
+// IsInTemplateString
+// Checks if a } ends the ${ expression of a template string rather than a block inside of it
+func (l *TypeScriptLexerBase) IsInTemplateString() bool {
+	return len(l.braceDepths) > 0 && l.braceDepths[len(l.braceDepths)-1] == 0
+}
+
+// ProcessOpenBrace
+// A block starts a scope that is strict when the scope around it is
+func (l *TypeScriptLexerBase) ProcessOpenBrace() {
+	if len(l.braceDepths) > 0 {
+		l.braceDepths[len(l.braceDepths)-1]++
+	}
+	l.useStrictCurrent = len(l.scopeStrictModes) > 0 && l.scopeStrictModes[len(l.scopeStrictModes)-1]
+	l.scopeStrictModes = append(l.scopeStrictModes, l.useStrictCurrent)
+}
+
+func (l *TypeScriptLexerBase) ProcessCloseBrace() {
+	if len(l.braceDepths) > 0 {
+		l.braceDepths[len(l.braceDepths)-1]--
+	}
+	if len(l.scopeStrictModes) > 0 {
+		l.useStrictCurrent = l.scopeStrictModes[len(l.scopeStrictModes)-1]
+		l.scopeStrictModes = l.scopeStrictModes[:len(l.scopeStrictModes)-1]
+	} else {
+		l.useStrictCurrent = false
+	}
+}
+
+// ProcessStringLiteral
+// "use strict" at the start of a file or block makes the rest of it strict
+func (l *TypeScriptLexerBase) ProcessStringLiteral() {
+	if l.lastToken != nil && l.lastToken.GetTokenType() != TypeScriptLexerOpenBrace {
+		return
+	}
+	text := l.GetText()
+	if text != `"use strict"` && text != `'use strict'` {
+		return
+	}
+	if len(l.scopeStrictModes) > 0 {
+		l.scopeStrictModes = l.scopeStrictModes[:len(l.scopeStrictModes)-1]
+	}
+	l.useStrictCurrent = true
+	l.scopeStrictModes = append(l.scopeStrictModes, true)
+}
+
+// IsStrictMode
+// Octal literals such as 017 are only allowed outside of strict mode
+func (l *TypeScriptLexerBase) IsStrictMode() bool {
+	return l.useStrictCurrent
+}
@@ -8,7 +8,7 @@ import (
 	//"strings"
 	"sync"
 
-	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
 )
 
 // Suppress unused import errors
@@ -17,7 +17,7 @@ var _ = strconv.Itoa
 var _ = sync.Once{}
 
 type TypeScriptParser struct {
-	*antlr.BaseParser
+	TypeScriptParserBase
 }
 
 var TypeScriptParserParserStaticData struct {
@@ -66,7 +66,7 @@ func typescriptparserParserInit() {
 		"DecimalLiteral", "HexIntegerLiteral", "OctalIntegerLiteral", "OctalIntegerLiteral2",
 		"BinaryIntegerLiteral", "Break", "Do", "Instanceof", "Typeof", "Case",
 		"Else", "New", "Var", "Catch", "Finally", "Return", "Void", "Continue",
-		"For", "Switch", "While", "Debugger", "Function_", "p", "With", "Default",
+		"For", "Switch", "While", "Debugger", "Function_", "This", "With", "Default",
 		"If", "Throw", "Delete", "In", "Try", "As", "From", "ReadOnly", "Async",
 		"Class", "Enum", "Extends", "Super", "Const", "Export", "Import", "Implements",
 		"Let", "Private", "Public", "Interface", "Package", "Protected", "Static",
@@ -1060,7 +1060,7 @@ const (
 	TypeScriptParserWhile                         = 77
 	TypeScriptParserDebugger                      = 78
 	TypeScriptParserFunction_                     = 79
-	TypeScriptParserp                          = 80
+	TypeScriptParserThis                          = 80
 	TypeScriptParserWith                          = 81
 	TypeScriptParserDefault                       = 82
 	TypeScriptParserIf                            = 83
@@ -1287,13 +1287,13 @@ type InitializerContext struct {
 
 func NewEmptyInitializerContext() *InitializerContext {
 	var p = new(InitializerContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_initializer
 	return p
 }
 
 func InitEmptyInitializerContext(p *InitializerContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_initializer
 }
 
@@ -1302,7 +1302,7 @@ func (*InitializerContext) IsInitializerContext() {}
 func NewInitializerContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InitializerContext {
 	var p = new(InitializerContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_initializer
@@ -1370,6 +1370,13 @@ func (p *TypeScriptParser) Initializer() (localctx IInitializerContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -1397,13 +1404,13 @@ type BindingPatternContext struct {
 
 func NewEmptyBindingPatternContext() *BindingPatternContext {
 	var p = new(BindingPatternContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_bindingPattern
 	return p
 }
 
 func InitEmptyBindingPatternContext(p *BindingPatternContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_bindingPattern
 }
 
@@ -1412,7 +1419,7 @@ func (*BindingPatternContext) IsBindingPatternContext() {}
 func NewBindingPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BindingPatternContext {
 	var p = new(BindingPatternContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_bindingPattern
@@ -1498,11 +1505,18 @@ func (p *TypeScriptParser) BindingPattern() (localctx IBindingPatternContext) {
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -1531,13 +1545,13 @@ type TypeParametersContext struct {
 
 func NewEmptyTypeParametersContext() *TypeParametersContext {
 	var p = new(TypeParametersContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeParameters
 	return p
 }
 
 func InitEmptyTypeParametersContext(p *TypeParametersContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeParameters
 }
 
@@ -1546,7 +1560,7 @@ func (*TypeParametersContext) IsTypeParametersContext() {}
 func NewTypeParametersContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeParametersContext {
 	var p = new(TypeParametersContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeParameters
@@ -1638,6 +1652,13 @@ func (p *TypeScriptParser) TypeParameters() (localctx ITypeParametersContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -1667,13 +1688,13 @@ type TypeParameterListContext struct {
 
 func NewEmptyTypeParameterListContext() *TypeParameterListContext {
 	var p = new(TypeParameterListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeParameterList
 	return p
 }
 
 func InitEmptyTypeParameterListContext(p *TypeParameterListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeParameterList
 }
 
@@ -1682,7 +1703,7 @@ func (*TypeParameterListContext) IsTypeParameterListContext() {}
 func NewTypeParameterListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeParameterListContext {
 	var p = new(TypeParameterListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeParameterList
@@ -1801,6 +1822,13 @@ func (p *TypeScriptParser) TypeParameterList() (localctx ITypeParameterListConte
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -1829,13 +1857,13 @@ type TypeParameterContext struct {
 
 func NewEmptyTypeParameterContext() *TypeParameterContext {
 	var p = new(TypeParameterContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeParameter
 	return p
 }
 
 func InitEmptyTypeParameterContext(p *TypeParameterContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeParameter
 }
 
@@ -1844,7 +1872,7 @@ func (*TypeParameterContext) IsTypeParameterContext() {}
 func NewTypeParameterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeParameterContext {
 	var p = new(TypeParameterContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeParameter
@@ -1955,11 +1983,18 @@ func (p *TypeScriptParser) TypeParameter() (localctx ITypeParameterContext) {
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -1987,13 +2022,13 @@ type ConstraintContext struct {
 
 func NewEmptyConstraintContext() *ConstraintContext {
 	var p = new(ConstraintContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_constraint
 	return p
 }
 
 func InitEmptyConstraintContext(p *ConstraintContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_constraint
 }
 
@@ -2002,7 +2037,7 @@ func (*ConstraintContext) IsConstraintContext() {}
 func NewConstraintContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstraintContext {
 	var p = new(ConstraintContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_constraint
@@ -2070,6 +2105,13 @@ func (p *TypeScriptParser) Constraint() (localctx IConstraintContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -2098,13 +2140,13 @@ type TypeArgumentsContext struct {
 
 func NewEmptyTypeArgumentsContext() *TypeArgumentsContext {
 	var p = new(TypeArgumentsContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeArguments
 	return p
 }
 
 func InitEmptyTypeArgumentsContext(p *TypeArgumentsContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeArguments
 }
 
@@ -2113,7 +2155,7 @@ func (*TypeArgumentsContext) IsTypeArgumentsContext() {}
 func NewTypeArgumentsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeArgumentsContext {
 	var p = new(TypeArgumentsContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeArguments
@@ -2205,6 +2247,13 @@ func (p *TypeScriptParser) TypeArguments() (localctx ITypeArgumentsContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -2234,13 +2283,13 @@ type TypeArgumentListContext struct {
 
 func NewEmptyTypeArgumentListContext() *TypeArgumentListContext {
 	var p = new(TypeArgumentListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeArgumentList
 	return p
 }
 
 func InitEmptyTypeArgumentListContext(p *TypeArgumentListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeArgumentList
 }
 
@@ -2249,7 +2298,7 @@ func (*TypeArgumentListContext) IsTypeArgumentListContext() {}
 func NewTypeArgumentListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeArgumentListContext {
 	var p = new(TypeArgumentListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeArgumentList
@@ -2368,6 +2417,13 @@ func (p *TypeScriptParser) TypeArgumentList() (localctx ITypeArgumentListContext
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -2394,13 +2450,13 @@ type TypeArgumentContext struct {
 
 func NewEmptyTypeArgumentContext() *TypeArgumentContext {
 	var p = new(TypeArgumentContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeArgument
 	return p
 }
 
 func InitEmptyTypeArgumentContext(p *TypeArgumentContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeArgument
 }
 
@@ -2409,7 +2465,7 @@ func (*TypeArgumentContext) IsTypeArgumentContext() {}
 func NewTypeArgumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeArgumentContext {
 	var p = new(TypeArgumentContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeArgument
@@ -2465,6 +2521,13 @@ func (p *TypeScriptParser) TypeArgument() (localctx ITypeArgumentContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -2495,13 +2558,13 @@ type Type_Context struct {
 
 func NewEmptyType_Context() *Type_Context {
 	var p = new(Type_Context)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_type_
 	return p
 }
 
 func InitEmptyType_Context(p *Type_Context) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_type_
 }
 
@@ -2510,7 +2573,7 @@ func (*Type_Context) IsType_Context() {}
 func NewType_Context(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Type_Context {
 	var p = new(Type_Context)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_type_
@@ -2617,7 +2680,7 @@ func (p *TypeScriptParser) Type_() (localctx IType_Context) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 7, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -2662,6 +2725,13 @@ func (p *TypeScriptParser) Type_() (localctx IType_Context) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -2684,13 +2754,13 @@ type UnionOrIntersectionOrPrimaryTypeContext struct {
 
 func NewEmptyUnionOrIntersectionOrPrimaryTypeContext() *UnionOrIntersectionOrPrimaryTypeContext {
 	var p = new(UnionOrIntersectionOrPrimaryTypeContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_unionOrIntersectionOrPrimaryType
 	return p
 }
 
 func InitEmptyUnionOrIntersectionOrPrimaryTypeContext(p *UnionOrIntersectionOrPrimaryTypeContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_unionOrIntersectionOrPrimaryType
 }
 
@@ -2699,7 +2769,7 @@ func (*UnionOrIntersectionOrPrimaryTypeContext) IsUnionOrIntersectionOrPrimaryTy
 func NewUnionOrIntersectionOrPrimaryTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UnionOrIntersectionOrPrimaryTypeContext {
 	var p = new(UnionOrIntersectionOrPrimaryTypeContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_unionOrIntersectionOrPrimaryType
@@ -2948,7 +3018,7 @@ func (p *TypeScriptParser) unionOrIntersectionOrPrimaryType(_p int) (localctx IU
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 9, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -2964,7 +3034,7 @@ func (p *TypeScriptParser) unionOrIntersectionOrPrimaryType(_p int) (localctx IU
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 8, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewUnionContext(p, NewUnionOrIntersectionOrPrimaryTypeContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, TypeScriptParserRULE_unionOrIntersectionOrPrimaryType)
@@ -3019,13 +3089,20 @@ func (p *TypeScriptParser) unionOrIntersectionOrPrimaryType(_p int) (localctx IU
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 9, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.UnrollRecursionContexts(_parentctx)
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -3048,13 +3125,13 @@ type PrimaryTypeContext struct {
 
 func NewEmptyPrimaryTypeContext() *PrimaryTypeContext {
 	var p = new(PrimaryTypeContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_primaryType
 	return p
 }
 
 func InitEmptyPrimaryTypeContext(p *PrimaryTypeContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_primaryType
 }
 
@@ -3063,7 +3140,7 @@ func (*PrimaryTypeContext) IsPrimaryTypeContext() {}
 func NewPrimaryTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrimaryTypeContext {
 	var p = new(PrimaryTypeContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_primaryType
@@ -3305,12 +3382,12 @@ func (s *ParenthesizedPrimTypeContext) ExitRule(listener antlr.ParseTreeListener
 	}
 }
 
-type pPrimTypeContext struct {
+type ThisPrimTypeContext struct {
 	PrimaryTypeContext
 }
 
-func NewpPrimTypeContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *pPrimTypeContext {
-	var p = new(pPrimTypeContext)
+func NewThisPrimTypeContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ThisPrimTypeContext {
+	var p = new(ThisPrimTypeContext)
 
 	InitEmptyPrimaryTypeContext(&p.PrimaryTypeContext)
 	p.parser = parser
@@ -3319,23 +3396,23 @@ func NewpPrimTypeContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *pPri
 	return p
 }
 
-func (s *pPrimTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *ThisPrimTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *pPrimTypeContext) p() antlr.TerminalNode {
-	return s.GetToken(TypeScriptParserp, 0)
+func (s *ThisPrimTypeContext) This() antlr.TerminalNode {
+	return s.GetToken(TypeScriptParserThis, 0)
 }
 
-func (s *pPrimTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ThisPrimTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TypeScriptParserListener); ok {
-		listenerT.EnterpPrimType(s)
+		listenerT.EnterThisPrimType(s)
 	}
 }
 
-func (s *pPrimTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ThisPrimTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TypeScriptParserListener); ok {
-		listenerT.ExitpPrimType(s)
+		listenerT.ExitThisPrimType(s)
 	}
 }
 
@@ -3553,7 +3630,7 @@ func (p *TypeScriptParser) primaryType(_p int) (localctx IPrimaryTypeContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 10, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewParenthesizedPrimTypeContext(p, localctx)
 		p.SetParserRuleContext(localctx)
@@ -3642,12 +3719,12 @@ func (p *TypeScriptParser) primaryType(_p int) (localctx IPrimaryTypeContext) {
 		}
 
 	case 7:
-		localctx = NewpPrimTypeContext(p, localctx)
+		localctx = NewThisPrimTypeContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
 			p.SetState(369)
-			p.Match(TypeScriptParserp)
+			p.Match(TypeScriptParserThis)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
@@ -3684,7 +3761,7 @@ func (p *TypeScriptParser) primaryType(_p int) (localctx IPrimaryTypeContext) {
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -3704,8 +3781,8 @@ func (p *TypeScriptParser) primaryType(_p int) (localctx IPrimaryTypeContext) {
 			}
 			p.SetState(377)
 
-			if !(notLineTerminator()) {
-				p.SetError(antlr.NewFailedPredicateException(p, "notLineTerminator()", ""))
+			if !(p.notLineTerminator()) {
+				p.SetError(antlr.NewFailedPredicateException(p, "p.notLineTerminator()", ""))
 				goto errorExit
 			}
 			{
@@ -3731,13 +3808,20 @@ func (p *TypeScriptParser) primaryType(_p int) (localctx IPrimaryTypeContext) {
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.UnrollRecursionContexts(_parentctx)
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -3769,13 +3853,13 @@ type PredefinedTypeContext struct {
 
 func NewEmptyPredefinedTypeContext() *PredefinedTypeContext {
 	var p = new(PredefinedTypeContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_predefinedType
 	return p
 }
 
 func InitEmptyPredefinedTypeContext(p *PredefinedTypeContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_predefinedType
 }
 
@@ -3784,7 +3868,7 @@ func (*PredefinedTypeContext) IsPredefinedTypeContext() {}
 func NewPredefinedTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PredefinedTypeContext {
 	var p = new(PredefinedTypeContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_predefinedType
@@ -3857,6 +3941,13 @@ func (p *TypeScriptParser) PredefinedType() (localctx IPredefinedTypeContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -3884,13 +3975,13 @@ type TypeReferenceContext struct {
 
 func NewEmptyTypeReferenceContext() *TypeReferenceContext {
 	var p = new(TypeReferenceContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeReference
 	return p
 }
 
 func InitEmptyTypeReferenceContext(p *TypeReferenceContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeReference
 }
 
@@ -3899,7 +3990,7 @@ func (*TypeReferenceContext) IsTypeReferenceContext() {}
 func NewTypeReferenceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeReferenceContext {
 	var p = new(TypeReferenceContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeReference
@@ -3972,7 +4063,7 @@ func (p *TypeScriptParser) TypeReference() (localctx ITypeReferenceContext) {
 	p.SetState(389)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 12, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(388)
 			p.NestedTypeGeneric()
@@ -3983,6 +4074,13 @@ func (p *TypeScriptParser) TypeReference() (localctx ITypeReferenceContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -4010,13 +4108,13 @@ type NestedTypeGenericContext struct {
 
 func NewEmptyNestedTypeGenericContext() *NestedTypeGenericContext {
 	var p = new(NestedTypeGenericContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_nestedTypeGeneric
 	return p
 }
 
 func InitEmptyNestedTypeGenericContext(p *NestedTypeGenericContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_nestedTypeGeneric
 }
 
@@ -4025,7 +4123,7 @@ func (*NestedTypeGenericContext) IsNestedTypeGenericContext() {}
 func NewNestedTypeGenericContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NestedTypeGenericContext {
 	var p = new(NestedTypeGenericContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_nestedTypeGeneric
@@ -4096,7 +4194,7 @@ func (p *TypeScriptParser) NestedTypeGeneric() (localctx INestedTypeGenericConte
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 13, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -4116,6 +4214,13 @@ func (p *TypeScriptParser) NestedTypeGeneric() (localctx INestedTypeGenericConte
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -4144,13 +4249,13 @@ type TypeGenericContext struct {
 
 func NewEmptyTypeGenericContext() *TypeGenericContext {
 	var p = new(TypeGenericContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeGeneric
 	return p
 }
 
 func InitEmptyTypeGenericContext(p *TypeGenericContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeGeneric
 }
 
@@ -4159,7 +4264,7 @@ func (*TypeGenericContext) IsTypeGenericContext() {}
 func NewTypeGenericContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeGenericContext {
 	var p = new(TypeGenericContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeGeneric
@@ -4239,6 +4344,13 @@ func (p *TypeScriptParser) TypeGeneric() (localctx ITypeGenericContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -4272,13 +4384,13 @@ type TypeIncludeGenericContext struct {
 
 func NewEmptyTypeIncludeGenericContext() *TypeIncludeGenericContext {
 	var p = new(TypeIncludeGenericContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeIncludeGeneric
 	return p
 }
 
 func InitEmptyTypeIncludeGenericContext(p *TypeIncludeGenericContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeIncludeGeneric
 }
 
@@ -4287,7 +4399,7 @@ func (*TypeIncludeGenericContext) IsTypeIncludeGenericContext() {}
 func NewTypeIncludeGenericContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeIncludeGenericContext {
 	var p = new(TypeIncludeGenericContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeIncludeGeneric
@@ -4462,11 +4574,18 @@ func (p *TypeScriptParser) TypeIncludeGeneric() (localctx ITypeIncludeGenericCon
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -4494,13 +4613,13 @@ type TypeNameContext struct {
 
 func NewEmptyTypeNameContext() *TypeNameContext {
 	var p = new(TypeNameContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeName
 	return p
 }
 
 func InitEmptyTypeNameContext(p *TypeNameContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeName
 }
 
@@ -4509,7 +4628,7 @@ func (*TypeNameContext) IsTypeNameContext() {}
 func NewTypeNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeNameContext {
 	var p = new(TypeNameContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeName
@@ -4568,7 +4687,7 @@ func (p *TypeScriptParser) TypeName() (localctx ITypeNameContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 15, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -4592,6 +4711,13 @@ func (p *TypeScriptParser) TypeName() (localctx ITypeNameContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -4620,13 +4746,13 @@ type ObjectTypeContext struct {
 
 func NewEmptyObjectTypeContext() *ObjectTypeContext {
 	var p = new(ObjectTypeContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_objectType
 	return p
 }
 
 func InitEmptyObjectTypeContext(p *ObjectTypeContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_objectType
 }
 
@@ -4635,7 +4761,7 @@ func (*ObjectTypeContext) IsObjectTypeContext() {}
 func NewObjectTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ObjectTypeContext {
 	var p = new(ObjectTypeContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_objectType
@@ -4727,6 +4853,13 @@ func (p *TypeScriptParser) ObjectType() (localctx IObjectTypeContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -4755,13 +4888,13 @@ type TypeBodyContext struct {
 
 func NewEmptyTypeBodyContext() *TypeBodyContext {
 	var p = new(TypeBodyContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeBody
 	return p
 }
 
 func InitEmptyTypeBodyContext(p *TypeBodyContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeBody
 }
 
@@ -4770,7 +4903,7 @@ func (*TypeBodyContext) IsTypeBodyContext() {}
 func NewTypeBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeBodyContext {
 	var p = new(TypeBodyContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeBody
@@ -4857,6 +4990,13 @@ func (p *TypeScriptParser) TypeBody() (localctx ITypeBodyContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -4888,13 +5028,13 @@ type TypeMemberListContext struct {
 
 func NewEmptyTypeMemberListContext() *TypeMemberListContext {
 	var p = new(TypeMemberListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeMemberList
 	return p
 }
 
 func InitEmptyTypeMemberListContext(p *TypeMemberListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeMemberList
 }
 
@@ -4903,7 +5043,7 @@ func (*TypeMemberListContext) IsTypeMemberListContext() {}
 func NewTypeMemberListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeMemberListContext {
 	var p = new(TypeMemberListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeMemberList
@@ -5007,7 +5147,7 @@ func (p *TypeScriptParser) TypeMemberList() (localctx ITypeMemberListContext) {
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 18, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -5035,13 +5175,20 @@ func (p *TypeScriptParser) TypeMemberList() (localctx ITypeMemberListContext) {
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 18, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -5074,13 +5221,13 @@ type TypeMemberContext struct {
 
 func NewEmptyTypeMemberContext() *TypeMemberContext {
 	var p = new(TypeMemberContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeMember
 	return p
 }
 
 func InitEmptyTypeMemberContext(p *TypeMemberContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeMember
 }
 
@@ -5089,7 +5236,7 @@ func (*TypeMemberContext) IsTypeMemberContext() {}
 func NewTypeMemberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeMemberContext {
 	var p = new(TypeMemberContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeMember
@@ -5230,7 +5377,7 @@ func (p *TypeScriptParser) TypeMember() (localctx ITypeMemberContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 20, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -5293,6 +5440,13 @@ func (p *TypeScriptParser) TypeMember() (localctx ITypeMemberContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -5321,13 +5475,13 @@ type ArrayTypeContext struct {
 
 func NewEmptyArrayTypeContext() *ArrayTypeContext {
 	var p = new(ArrayTypeContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrayType
 	return p
 }
 
 func InitEmptyArrayTypeContext(p *ArrayTypeContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrayType
 }
 
@@ -5336,7 +5490,7 @@ func (*ArrayTypeContext) IsArrayTypeContext() {}
 func NewArrayTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayTypeContext {
 	var p = new(ArrayTypeContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_arrayType
@@ -5400,8 +5554,8 @@ func (p *TypeScriptParser) ArrayType() (localctx IArrayTypeContext) {
 	}
 	p.SetState(444)
 
-	if !(notLineTerminator()) {
-		p.SetError(antlr.NewFailedPredicateException(p, "notLineTerminator()", ""))
+	if !(p.notLineTerminator()) {
+		p.SetError(antlr.NewFailedPredicateException(p, "p.notLineTerminator()", ""))
 		goto errorExit
 	}
 	{
@@ -5422,6 +5576,13 @@ func (p *TypeScriptParser) ArrayType() (localctx IArrayTypeContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -5450,13 +5611,13 @@ type TupleTypeContext struct {
 
 func NewEmptyTupleTypeContext() *TupleTypeContext {
 	var p = new(TupleTypeContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_tupleType
 	return p
 }
 
 func InitEmptyTupleTypeContext(p *TupleTypeContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_tupleType
 }
 
@@ -5465,7 +5626,7 @@ func (*TupleTypeContext) IsTupleTypeContext() {}
 func NewTupleTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TupleTypeContext {
 	var p = new(TupleTypeContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_tupleType
@@ -5545,6 +5706,13 @@ func (p *TypeScriptParser) TupleType() (localctx ITupleTypeContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -5574,13 +5742,13 @@ type TupleElementTypesContext struct {
 
 func NewEmptyTupleElementTypesContext() *TupleElementTypesContext {
 	var p = new(TupleElementTypesContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_tupleElementTypes
 	return p
 }
 
 func InitEmptyTupleElementTypesContext(p *TupleElementTypesContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_tupleElementTypes
 }
 
@@ -5589,7 +5757,7 @@ func (*TupleElementTypesContext) IsTupleElementTypesContext() {}
 func NewTupleElementTypesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TupleElementTypesContext {
 	var p = new(TupleElementTypesContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_tupleElementTypes
@@ -5708,6 +5876,13 @@ func (p *TypeScriptParser) TupleElementTypes() (localctx ITupleElementTypesConte
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -5739,13 +5914,13 @@ type FunctionTypeContext struct {
 
 func NewEmptyFunctionTypeContext() *FunctionTypeContext {
 	var p = new(FunctionTypeContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_functionType
 	return p
 }
 
 func InitEmptyFunctionTypeContext(p *FunctionTypeContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_functionType
 }
 
@@ -5754,7 +5929,7 @@ func (*FunctionTypeContext) IsFunctionTypeContext() {}
 func NewFunctionTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionTypeContext {
 	var p = new(FunctionTypeContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_functionType
@@ -5908,6 +6083,13 @@ func (p *TypeScriptParser) FunctionType() (localctx IFunctionTypeContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -5940,13 +6122,13 @@ type ConstructorTypeContext struct {
 
 func NewEmptyConstructorTypeContext() *ConstructorTypeContext {
 	var p = new(ConstructorTypeContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_constructorType
 	return p
 }
 
 func InitEmptyConstructorTypeContext(p *ConstructorTypeContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_constructorType
 }
 
@@ -5955,7 +6137,7 @@ func (*ConstructorTypeContext) IsConstructorTypeContext() {}
 func NewConstructorTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstructorTypeContext {
 	var p = new(ConstructorTypeContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_constructorType
@@ -6121,6 +6303,13 @@ func (p *TypeScriptParser) ConstructorType() (localctx IConstructorTypeContext)
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -6148,13 +6337,13 @@ type TypeQueryContext struct {
 
 func NewEmptyTypeQueryContext() *TypeQueryContext {
 	var p = new(TypeQueryContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeQuery
 	return p
 }
 
 func InitEmptyTypeQueryContext(p *TypeQueryContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeQuery
 }
 
@@ -6163,7 +6352,7 @@ func (*TypeQueryContext) IsTypeQueryContext() {}
 func NewTypeQueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeQueryContext {
 	var p = new(TypeQueryContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeQuery
@@ -6231,6 +6420,13 @@ func (p *TypeScriptParser) TypeQuery() (localctx ITypeQueryContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -6261,13 +6457,13 @@ type TypeQueryExpressionContext struct {
 
 func NewEmptyTypeQueryExpressionContext() *TypeQueryExpressionContext {
 	var p = new(TypeQueryExpressionContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeQueryExpression
 	return p
 }
 
 func InitEmptyTypeQueryExpressionContext(p *TypeQueryExpressionContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeQueryExpression
 }
 
@@ -6276,7 +6472,7 @@ func (*TypeQueryExpressionContext) IsTypeQueryExpressionContext() {}
 func NewTypeQueryExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeQueryExpressionContext {
 	var p = new(TypeQueryExpressionContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeQueryExpression
@@ -6370,7 +6566,7 @@ func (p *TypeScriptParser) TypeQueryExpression() (localctx ITypeQueryExpressionC
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 27, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 27, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -6407,13 +6603,13 @@ func (p *TypeScriptParser) TypeQueryExpression() (localctx ITypeQueryExpressionC
 				}
 
 			default:
-				p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 				goto errorExit
 			}
 
 			p.SetState(492)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 26, p.GetParserRuleContext())
 			if p.HasError() {
 				goto errorExit
 			}
@@ -6428,6 +6624,13 @@ func (p *TypeScriptParser) TypeQueryExpression() (localctx ITypeQueryExpressionC
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -6459,13 +6662,13 @@ type PropertySignaturContext struct {
 
 func NewEmptyPropertySignaturContext() *PropertySignaturContext {
 	var p = new(PropertySignaturContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_propertySignatur
 	return p
 }
 
 func InitEmptyPropertySignaturContext(p *PropertySignaturContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_propertySignatur
 }
 
@@ -6474,7 +6677,7 @@ func (*PropertySignaturContext) IsPropertySignaturContext() {}
 func NewPropertySignaturContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PropertySignaturContext {
 	var p = new(PropertySignaturContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_propertySignatur
@@ -6573,7 +6776,7 @@ func (p *TypeScriptParser) PropertySignatur() (localctx IPropertySignaturContext
 	p.SetState(499)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 28, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(498)
 			p.Match(TypeScriptParserReadOnly)
@@ -6646,6 +6849,13 @@ func (p *TypeScriptParser) PropertySignatur() (localctx IPropertySignaturContext
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -6673,13 +6883,13 @@ type TypeAnnotationContext struct {
 
 func NewEmptyTypeAnnotationContext() *TypeAnnotationContext {
 	var p = new(TypeAnnotationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeAnnotation
 	return p
 }
 
 func InitEmptyTypeAnnotationContext(p *TypeAnnotationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeAnnotation
 }
 
@@ -6688,7 +6898,7 @@ func (*TypeAnnotationContext) IsTypeAnnotationContext() {}
 func NewTypeAnnotationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeAnnotationContext {
 	var p = new(TypeAnnotationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeAnnotation
@@ -6756,6 +6966,13 @@ func (p *TypeScriptParser) TypeAnnotation() (localctx ITypeAnnotationContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -6786,13 +7003,13 @@ type CallSignatureContext struct {
 
 func NewEmptyCallSignatureContext() *CallSignatureContext {
 	var p = new(CallSignatureContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_callSignature
 	return p
 }
 
 func InitEmptyCallSignatureContext(p *CallSignatureContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_callSignature
 }
 
@@ -6801,7 +7018,7 @@ func (*CallSignatureContext) IsCallSignatureContext() {}
 func NewCallSignatureContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CallSignatureContext {
 	var p = new(CallSignatureContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_callSignature
@@ -6940,7 +7157,7 @@ func (p *TypeScriptParser) CallSignature() (localctx ICallSignatureContext) {
 	p.SetState(524)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 34, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(523)
 			p.TypeAnnotation()
@@ -6951,6 +7168,13 @@ func (p *TypeScriptParser) CallSignature() (localctx ICallSignatureContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -6981,13 +7205,13 @@ type ParameterListContext struct {
 
 func NewEmptyParameterListContext() *ParameterListContext {
 	var p = new(ParameterListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_parameterList
 	return p
 }
 
 func InitEmptyParameterListContext(p *ParameterListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_parameterList
 }
 
@@ -6996,7 +7220,7 @@ func (*ParameterListContext) IsParameterListContext() {}
 func NewParameterListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParameterListContext {
 	var p = new(ParameterListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_parameterList
@@ -7112,7 +7336,7 @@ func (p *TypeScriptParser) ParameterList() (localctx IParameterListContext) {
 			p.RestParameter()
 		}
 
-	case TypeScriptParserOpenBracket, TypeScriptParserOpenBrace, TypeScriptParserNullLiteral, TypeScriptParserBooleanLiteral, TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserp, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule, TypeScriptParserAt, TypeScriptParserIdentifier:
+	case TypeScriptParserOpenBracket, TypeScriptParserOpenBrace, TypeScriptParserNullLiteral, TypeScriptParserBooleanLiteral, TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserThis, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule, TypeScriptParserAt, TypeScriptParserIdentifier:
 		p.EnterOuterAlt(localctx, 2)
 		{
 			p.SetState(527)
@@ -7123,7 +7347,7 @@ func (p *TypeScriptParser) ParameterList() (localctx IParameterListContext) {
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 35, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -7148,7 +7372,7 @@ func (p *TypeScriptParser) ParameterList() (localctx IParameterListContext) {
 			if p.HasError() {
 				goto errorExit
 			}
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 35, p.GetParserRuleContext())
 			if p.HasError() {
 				goto errorExit
 			}
@@ -7177,11 +7401,18 @@ func (p *TypeScriptParser) ParameterList() (localctx IParameterListContext) {
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -7211,13 +7442,13 @@ type RequiredParameterListContext struct {
 
 func NewEmptyRequiredParameterListContext() *RequiredParameterListContext {
 	var p = new(RequiredParameterListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_requiredParameterList
 	return p
 }
 
 func InitEmptyRequiredParameterListContext(p *RequiredParameterListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_requiredParameterList
 }
 
@@ -7226,7 +7457,7 @@ func (*RequiredParameterListContext) IsRequiredParameterListContext() {}
 func NewRequiredParameterListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RequiredParameterListContext {
 	var p = new(RequiredParameterListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_requiredParameterList
@@ -7345,6 +7576,13 @@ func (p *TypeScriptParser) RequiredParameterList() (localctx IRequiredParameterL
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -7372,13 +7610,13 @@ type ParameterContext struct {
 
 func NewEmptyParameterContext() *ParameterContext {
 	var p = new(ParameterContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_parameter
 	return p
 }
 
 func InitEmptyParameterContext(p *ParameterContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_parameter
 }
 
@@ -7387,7 +7625,7 @@ func (*ParameterContext) IsParameterContext() {}
 func NewParameterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ParameterContext {
 	var p = new(ParameterContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_parameter
@@ -7458,7 +7696,7 @@ func (p *TypeScriptParser) Parameter() (localctx IParameterContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 39, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 39, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -7478,6 +7716,13 @@ func (p *TypeScriptParser) Parameter() (localctx IParameterContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -7509,13 +7754,13 @@ type OptionalParameterContext struct {
 
 func NewEmptyOptionalParameterContext() *OptionalParameterContext {
 	var p = new(OptionalParameterContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_optionalParameter
 	return p
 }
 
 func InitEmptyOptionalParameterContext(p *OptionalParameterContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_optionalParameter
 }
 
@@ -7524,7 +7769,7 @@ func (*OptionalParameterContext) IsOptionalParameterContext() {}
 func NewOptionalParameterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OptionalParameterContext {
 	var p = new(OptionalParameterContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_optionalParameter
@@ -7662,7 +7907,7 @@ func (p *TypeScriptParser) OptionalParameter() (localctx IOptionalParameterConte
 	p.SetState(557)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 41, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(556)
 			p.AccessibilityModifier()
@@ -7727,11 +7972,18 @@ func (p *TypeScriptParser) OptionalParameter() (localctx IOptionalParameterConte
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -7760,13 +8012,13 @@ type RestParameterContext struct {
 
 func NewEmptyRestParameterContext() *RestParameterContext {
 	var p = new(RestParameterContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_restParameter
 	return p
 }
 
 func InitEmptyRestParameterContext(p *RestParameterContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_restParameter
 }
 
@@ -7775,7 +8027,7 @@ func (*RestParameterContext) IsRestParameterContext() {}
 func NewRestParameterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RestParameterContext {
 	var p = new(RestParameterContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_restParameter
@@ -7875,6 +8127,13 @@ func (p *TypeScriptParser) RestParameter() (localctx IRestParameterContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -7904,13 +8163,13 @@ type RequiredParameterContext struct {
 
 func NewEmptyRequiredParameterContext() *RequiredParameterContext {
 	var p = new(RequiredParameterContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_requiredParameter
 	return p
 }
 
 func InitEmptyRequiredParameterContext(p *RequiredParameterContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_requiredParameter
 }
 
@@ -7919,7 +8178,7 @@ func (*RequiredParameterContext) IsRequiredParameterContext() {}
 func NewRequiredParameterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RequiredParameterContext {
 	var p = new(RequiredParameterContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_requiredParameter
@@ -8036,7 +8295,7 @@ func (p *TypeScriptParser) RequiredParameter() (localctx IRequiredParameterConte
 	p.SetState(579)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 47, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(578)
 			p.AccessibilityModifier()
@@ -8065,6 +8324,13 @@ func (p *TypeScriptParser) RequiredParameter() (localctx IRequiredParameterConte
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -8093,13 +8359,13 @@ type AccessibilityModifierContext struct {
 
 func NewEmptyAccessibilityModifierContext() *AccessibilityModifierContext {
 	var p = new(AccessibilityModifierContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_accessibilityModifier
 	return p
 }
 
 func InitEmptyAccessibilityModifierContext(p *AccessibilityModifierContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_accessibilityModifier
 }
 
@@ -8108,7 +8374,7 @@ func (*AccessibilityModifierContext) IsAccessibilityModifierContext() {}
 func NewAccessibilityModifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AccessibilityModifierContext {
 	var p = new(AccessibilityModifierContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_accessibilityModifier
@@ -8169,6 +8435,13 @@ func (p *TypeScriptParser) AccessibilityModifier() (localctx IAccessibilityModif
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -8196,13 +8469,13 @@ type IdentifierOrPatternContext struct {
 
 func NewEmptyIdentifierOrPatternContext() *IdentifierOrPatternContext {
 	var p = new(IdentifierOrPatternContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_identifierOrPattern
 	return p
 }
 
 func InitEmptyIdentifierOrPatternContext(p *IdentifierOrPatternContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_identifierOrPattern
 }
 
@@ -8211,7 +8484,7 @@ func (*IdentifierOrPatternContext) IsIdentifierOrPatternContext() {}
 func NewIdentifierOrPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierOrPatternContext {
 	var p = new(IdentifierOrPatternContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_identifierOrPattern
@@ -8283,7 +8556,7 @@ func (p *TypeScriptParser) IdentifierOrPattern() (localctx IIdentifierOrPatternC
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case TypeScriptParserNullLiteral, TypeScriptParserBooleanLiteral, TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserp, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule, TypeScriptParserIdentifier:
+	case TypeScriptParserNullLiteral, TypeScriptParserBooleanLiteral, TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserThis, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule, TypeScriptParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
 		{
 			p.SetState(587)
@@ -8298,11 +8571,18 @@ func (p *TypeScriptParser) IdentifierOrPattern() (localctx IIdentifierOrPatternC
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -8334,13 +8614,13 @@ type ConstructSignatureContext struct {
 
 func NewEmptyConstructSignatureContext() *ConstructSignatureContext {
 	var p = new(ConstructSignatureContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_constructSignature
 	return p
 }
 
 func InitEmptyConstructSignatureContext(p *ConstructSignatureContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_constructSignature
 }
 
@@ -8349,7 +8629,7 @@ func (*ConstructSignatureContext) IsConstructSignatureContext() {}
 func NewConstructSignatureContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstructSignatureContext {
 	var p = new(ConstructSignatureContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_constructSignature
@@ -8513,6 +8793,13 @@ func (p *TypeScriptParser) ConstructSignature() (localctx IConstructSignatureCon
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -8545,13 +8832,13 @@ type IndexSignatureContext struct {
 
 func NewEmptyIndexSignatureContext() *IndexSignatureContext {
 	var p = new(IndexSignatureContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_indexSignature
 	return p
 }
 
 func InitEmptyIndexSignatureContext(p *IndexSignatureContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_indexSignature
 }
 
@@ -8560,7 +8847,7 @@ func (*IndexSignatureContext) IsIndexSignatureContext() {}
 func NewIndexSignatureContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IndexSignatureContext {
 	var p = new(IndexSignatureContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_indexSignature
@@ -8685,6 +8972,13 @@ func (p *TypeScriptParser) IndexSignature() (localctx IIndexSignatureContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -8713,13 +9007,13 @@ type MethodSignatureContext struct {
 
 func NewEmptyMethodSignatureContext() *MethodSignatureContext {
 	var p = new(MethodSignatureContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_methodSignature
 	return p
 }
 
 func InitEmptyMethodSignatureContext(p *MethodSignatureContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_methodSignature
 }
 
@@ -8728,7 +9022,7 @@ func (*MethodSignatureContext) IsMethodSignatureContext() {}
 func NewMethodSignatureContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethodSignatureContext {
 	var p = new(MethodSignatureContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_methodSignature
@@ -8828,6 +9122,13 @@ func (p *TypeScriptParser) MethodSignature() (localctx IMethodSignatureContext)
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -8859,13 +9160,13 @@ type TypeAliasDeclarationContext struct {
 
 func NewEmptyTypeAliasDeclarationContext() *TypeAliasDeclarationContext {
 	var p = new(TypeAliasDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeAliasDeclaration
 	return p
 }
 
 func InitEmptyTypeAliasDeclarationContext(p *TypeAliasDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_typeAliasDeclaration
 }
 
@@ -8874,7 +9175,7 @@ func (*TypeAliasDeclarationContext) IsTypeAliasDeclarationContext() {}
 func NewTypeAliasDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeAliasDeclarationContext {
 	var p = new(TypeAliasDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_typeAliasDeclaration
@@ -9010,6 +9311,13 @@ func (p *TypeScriptParser) TypeAliasDeclaration() (localctx ITypeAliasDeclaratio
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -9044,13 +9352,13 @@ type ConstructorDeclarationContext struct {
 
 func NewEmptyConstructorDeclarationContext() *ConstructorDeclarationContext {
 	var p = new(ConstructorDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_constructorDeclaration
 	return p
 }
 
 func InitEmptyConstructorDeclarationContext(p *ConstructorDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_constructorDeclaration
 }
 
@@ -9059,7 +9367,7 @@ func (*ConstructorDeclarationContext) IsConstructorDeclarationContext() {}
 func NewConstructorDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstructorDeclarationContext {
 	var p = new(ConstructorDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_constructorDeclaration
@@ -9222,7 +9530,7 @@ func (p *TypeScriptParser) ConstructorDeclaration() (localctx IConstructorDeclar
 	p.SetState(639)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 57, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(634)
 			p.Match(TypeScriptParserOpenBrace)
@@ -9246,7 +9554,7 @@ func (p *TypeScriptParser) ConstructorDeclaration() (localctx IConstructorDeclar
 
 	} else if p.HasError() { // JIM
 		goto errorExit
-	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) == 2 {
+	} else if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 57, p.GetParserRuleContext()) == 2 {
 		{
 			p.SetState(638)
 			p.Match(TypeScriptParserSemiColon)
@@ -9261,6 +9569,13 @@ func (p *TypeScriptParser) ConstructorDeclaration() (localctx IConstructorDeclar
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -9294,13 +9609,13 @@ type InterfaceDeclarationContext struct {
 
 func NewEmptyInterfaceDeclarationContext() *InterfaceDeclarationContext {
 	var p = new(InterfaceDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_interfaceDeclaration
 	return p
 }
 
 func InitEmptyInterfaceDeclarationContext(p *InterfaceDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_interfaceDeclaration
 }
 
@@ -9309,7 +9624,7 @@ func (*InterfaceDeclarationContext) IsInterfaceDeclarationContext() {}
 func NewInterfaceDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InterfaceDeclarationContext {
 	var p = new(InterfaceDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_interfaceDeclaration
@@ -9500,7 +9815,7 @@ func (p *TypeScriptParser) InterfaceDeclaration() (localctx IInterfaceDeclaratio
 	p.SetState(657)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 62, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(656)
 			p.Match(TypeScriptParserSemiColon)
@@ -9515,6 +9830,13 @@ func (p *TypeScriptParser) InterfaceDeclaration() (localctx IInterfaceDeclaratio
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -9542,13 +9864,13 @@ type InterfaceExtendsClauseContext struct {
 
 func NewEmptyInterfaceExtendsClauseContext() *InterfaceExtendsClauseContext {
 	var p = new(InterfaceExtendsClauseContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_interfaceExtendsClause
 	return p
 }
 
 func InitEmptyInterfaceExtendsClauseContext(p *InterfaceExtendsClauseContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_interfaceExtendsClause
 }
 
@@ -9557,7 +9879,7 @@ func (*InterfaceExtendsClauseContext) IsInterfaceExtendsClauseContext() {}
 func NewInterfaceExtendsClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InterfaceExtendsClauseContext {
 	var p = new(InterfaceExtendsClauseContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_interfaceExtendsClause
@@ -9625,6 +9947,13 @@ func (p *TypeScriptParser) InterfaceExtendsClause() (localctx IInterfaceExtendsC
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -9654,13 +9983,13 @@ type ClassOrInterfaceTypeListContext struct {
 
 func NewEmptyClassOrInterfaceTypeListContext() *ClassOrInterfaceTypeListContext {
 	var p = new(ClassOrInterfaceTypeListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classOrInterfaceTypeList
 	return p
 }
 
 func InitEmptyClassOrInterfaceTypeListContext(p *ClassOrInterfaceTypeListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classOrInterfaceTypeList
 }
 
@@ -9669,7 +9998,7 @@ func (*ClassOrInterfaceTypeListContext) IsClassOrInterfaceTypeListContext() {}
 func NewClassOrInterfaceTypeListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClassOrInterfaceTypeListContext {
 	var p = new(ClassOrInterfaceTypeListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_classOrInterfaceTypeList
@@ -9788,6 +10117,13 @@ func (p *TypeScriptParser) ClassOrInterfaceTypeList() (localctx IClassOrInterfac
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -9819,13 +10155,13 @@ type EnumDeclarationContext struct {
 
 func NewEmptyEnumDeclarationContext() *EnumDeclarationContext {
 	var p = new(EnumDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_enumDeclaration
 	return p
 }
 
 func InitEmptyEnumDeclarationContext(p *EnumDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_enumDeclaration
 }
 
@@ -9834,7 +10170,7 @@ func (*EnumDeclarationContext) IsEnumDeclarationContext() {}
 func NewEnumDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumDeclarationContext {
 	var p = new(EnumDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_enumDeclaration
@@ -9972,6 +10308,13 @@ func (p *TypeScriptParser) EnumDeclaration() (localctx IEnumDeclarationContext)
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -9999,13 +10342,13 @@ type EnumBodyContext struct {
 
 func NewEmptyEnumBodyContext() *EnumBodyContext {
 	var p = new(EnumBodyContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_enumBody
 	return p
 }
 
 func InitEmptyEnumBodyContext(p *EnumBodyContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_enumBody
 }
 
@@ -10014,7 +10357,7 @@ func (*EnumBodyContext) IsEnumBodyContext() {}
 func NewEnumBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumBodyContext {
 	var p = new(EnumBodyContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_enumBody
@@ -10094,6 +10437,13 @@ func (p *TypeScriptParser) EnumBody() (localctx IEnumBodyContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -10123,13 +10473,13 @@ type EnumMemberListContext struct {
 
 func NewEmptyEnumMemberListContext() *EnumMemberListContext {
 	var p = new(EnumMemberListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_enumMemberList
 	return p
 }
 
 func InitEmptyEnumMemberListContext(p *EnumMemberListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_enumMemberList
 }
 
@@ -10138,7 +10488,7 @@ func (*EnumMemberListContext) IsEnumMemberListContext() {}
 func NewEnumMemberListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumMemberListContext {
 	var p = new(EnumMemberListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_enumMemberList
@@ -10232,7 +10582,7 @@ func (p *TypeScriptParser) EnumMemberList() (localctx IEnumMemberListContext) {
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 67, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -10257,13 +10607,20 @@ func (p *TypeScriptParser) EnumMemberList() (localctx IEnumMemberListContext) {
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 67, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -10292,13 +10649,13 @@ type EnumMemberContext struct {
 
 func NewEmptyEnumMemberContext() *EnumMemberContext {
 	var p = new(EnumMemberContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_enumMember
 	return p
 }
 
 func InitEmptyEnumMemberContext(p *EnumMemberContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_enumMember
 }
 
@@ -10307,7 +10664,7 @@ func (*EnumMemberContext) IsEnumMemberContext() {}
 func NewEnumMemberContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumMemberContext {
 	var p = new(EnumMemberContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_enumMember
@@ -10407,6 +10764,13 @@ func (p *TypeScriptParser) EnumMember() (localctx IEnumMemberContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -10437,13 +10801,13 @@ type NamespaceDeclarationContext struct {
 
 func NewEmptyNamespaceDeclarationContext() *NamespaceDeclarationContext {
 	var p = new(NamespaceDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_namespaceDeclaration
 	return p
 }
 
 func InitEmptyNamespaceDeclarationContext(p *NamespaceDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_namespaceDeclaration
 }
 
@@ -10452,7 +10816,7 @@ func (*NamespaceDeclarationContext) IsNamespaceDeclarationContext() {}
 func NewNamespaceDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceDeclarationContext {
 	var p = new(NamespaceDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_namespaceDeclaration
@@ -10553,7 +10917,7 @@ func (p *TypeScriptParser) NamespaceDeclaration() (localctx INamespaceDeclaratio
 	p.SetState(702)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 69, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 69, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(701)
 			p.StatementList()
@@ -10572,6 +10936,13 @@ func (p *TypeScriptParser) NamespaceDeclaration() (localctx INamespaceDeclaratio
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -10601,13 +10972,13 @@ type NamespaceNameContext struct {
 
 func NewEmptyNamespaceNameContext() *NamespaceNameContext {
 	var p = new(NamespaceNameContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_namespaceName
 	return p
 }
 
 func InitEmptyNamespaceNameContext(p *NamespaceNameContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_namespaceName
 }
 
@@ -10616,7 +10987,7 @@ func (*NamespaceNameContext) IsNamespaceNameContext() {}
 func NewNamespaceNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceNameContext {
 	var p = new(NamespaceNameContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_namespaceName
@@ -10683,7 +11054,7 @@ func (p *TypeScriptParser) NamespaceName() (localctx INamespaceNameContext) {
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 71, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 71, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -10728,13 +11099,20 @@ func (p *TypeScriptParser) NamespaceName() (localctx INamespaceNameContext) {
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 71, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 71, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -10764,13 +11142,13 @@ type ImportAliasDeclarationContext struct {
 
 func NewEmptyImportAliasDeclarationContext() *ImportAliasDeclarationContext {
 	var p = new(ImportAliasDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_importAliasDeclaration
 	return p
 }
 
 func InitEmptyImportAliasDeclarationContext(p *ImportAliasDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_importAliasDeclaration
 }
 
@@ -10779,7 +11157,7 @@ func (*ImportAliasDeclarationContext) IsImportAliasDeclarationContext() {}
 func NewImportAliasDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ImportAliasDeclarationContext {
 	var p = new(ImportAliasDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_importAliasDeclaration
@@ -10871,6 +11249,13 @@ func (p *TypeScriptParser) ImportAliasDeclaration() (localctx IImportAliasDeclar
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -10898,13 +11283,13 @@ type DecoratorListContext struct {
 
 func NewEmptyDecoratorListContext() *DecoratorListContext {
 	var p = new(DecoratorListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_decoratorList
 	return p
 }
 
 func InitEmptyDecoratorListContext(p *DecoratorListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_decoratorList
 }
 
@@ -10913,7 +11298,7 @@ func (*DecoratorListContext) IsDecoratorListContext() {}
 func NewDecoratorListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecoratorListContext {
 	var p = new(DecoratorListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_decoratorList
@@ -11012,6 +11397,13 @@ func (p *TypeScriptParser) DecoratorList() (localctx IDecoratorListContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -11040,13 +11432,13 @@ type DecoratorContext struct {
 
 func NewEmptyDecoratorContext() *DecoratorContext {
 	var p = new(DecoratorContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_decorator
 	return p
 }
 
 func InitEmptyDecoratorContext(p *DecoratorContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_decorator
 }
 
@@ -11055,7 +11447,7 @@ func (*DecoratorContext) IsDecoratorContext() {}
 func NewDecoratorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecoratorContext {
 	var p = new(DecoratorContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_decorator
@@ -11139,7 +11531,7 @@ func (p *TypeScriptParser) Decorator() (localctx IDecoratorContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 73, p.GetParserRuleContext()) {
 	case 1:
 		{
 			p.SetState(729)
@@ -11157,6 +11549,13 @@ func (p *TypeScriptParser) Decorator() (localctx IDecoratorContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -11189,13 +11588,13 @@ type DecoratorMemberExpressionContext struct {
 
 func NewEmptyDecoratorMemberExpressionContext() *DecoratorMemberExpressionContext {
 	var p = new(DecoratorMemberExpressionContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_decoratorMemberExpression
 	return p
 }
 
 func InitEmptyDecoratorMemberExpressionContext(p *DecoratorMemberExpressionContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_decoratorMemberExpression
 }
 
@@ -11204,7 +11603,7 @@ func (*DecoratorMemberExpressionContext) IsDecoratorMemberExpressionContext() {}
 func NewDecoratorMemberExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecoratorMemberExpressionContext {
 	var p = new(DecoratorMemberExpressionContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_decoratorMemberExpression
@@ -11354,7 +11753,7 @@ func (p *TypeScriptParser) decoratorMemberExpression(_p int) (localctx IDecorato
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
@@ -11363,7 +11762,7 @@ func (p *TypeScriptParser) decoratorMemberExpression(_p int) (localctx IDecorato
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 75, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -11400,13 +11799,20 @@ func (p *TypeScriptParser) decoratorMemberExpression(_p int) (localctx IDecorato
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 75, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.UnrollRecursionContexts(_parentctx)
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -11434,13 +11840,13 @@ type DecoratorCallExpressionContext struct {
 
 func NewEmptyDecoratorCallExpressionContext() *DecoratorCallExpressionContext {
 	var p = new(DecoratorCallExpressionContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_decoratorCallExpression
 	return p
 }
 
 func InitEmptyDecoratorCallExpressionContext(p *DecoratorCallExpressionContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_decoratorCallExpression
 }
 
@@ -11449,7 +11855,7 @@ func (*DecoratorCallExpressionContext) IsDecoratorCallExpressionContext() {}
 func NewDecoratorCallExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecoratorCallExpressionContext {
 	var p = new(DecoratorCallExpressionContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_decoratorCallExpression
@@ -11525,6 +11931,13 @@ func (p *TypeScriptParser) DecoratorCallExpression() (localctx IDecoratorCallExp
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -11552,13 +11965,13 @@ type ProgramContext struct {
 
 func NewEmptyProgramContext() *ProgramContext {
 	var p = new(ProgramContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_program
 	return p
 }
 
 func InitEmptyProgramContext(p *ProgramContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_program
 }
 
@@ -11567,7 +11980,7 @@ func (*ProgramContext) IsProgramContext() {}
 func NewProgramContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ProgramContext {
 	var p = new(ProgramContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_program
@@ -11624,7 +12037,7 @@ func (p *TypeScriptParser) Program() (localctx IProgramContext) {
 	p.SetState(753)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 76, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(752)
 			p.SourceElements()
@@ -11643,6 +12056,13 @@ func (p *TypeScriptParser) Program() (localctx IProgramContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -11670,13 +12090,13 @@ type SourceElementContext struct {
 
 func NewEmptySourceElementContext() *SourceElementContext {
 	var p = new(SourceElementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_sourceElement
 	return p
 }
 
 func InitEmptySourceElementContext(p *SourceElementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_sourceElement
 }
 
@@ -11685,7 +12105,7 @@ func (*SourceElementContext) IsSourceElementContext() {}
 func NewSourceElementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceElementContext {
 	var p = new(SourceElementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_sourceElement
@@ -11742,7 +12162,7 @@ func (p *TypeScriptParser) SourceElement() (localctx ISourceElementContext) {
 	p.SetState(758)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 77, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 77, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(757)
 			p.Match(TypeScriptParserExport)
@@ -11761,6 +12181,13 @@ func (p *TypeScriptParser) SourceElement() (localctx ISourceElementContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -11815,13 +12242,13 @@ type StatementContext struct {
 
 func NewEmptyStatementContext() *StatementContext {
 	var p = new(StatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_statement
 	return p
 }
 
 func InitEmptyStatementContext(p *StatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_statement
 }
 
@@ -11830,7 +12257,7 @@ func (*StatementContext) IsStatementContext() {}
 func NewStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatementContext {
 	var p = new(StatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_statement
@@ -12321,7 +12748,7 @@ func (p *TypeScriptParser) Statement() (localctx IStatementContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 78, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -12531,6 +12958,13 @@ func (p *TypeScriptParser) Statement() (localctx IStatementContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -12559,13 +12993,13 @@ type BlockContext struct {
 
 func NewEmptyBlockContext() *BlockContext {
 	var p = new(BlockContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_block
 	return p
 }
 
 func InitEmptyBlockContext(p *BlockContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_block
 }
 
@@ -12574,7 +13008,7 @@ func (*BlockContext) IsBlockContext() {}
 func NewBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BlockContext {
 	var p = new(BlockContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_block
@@ -12643,7 +13077,7 @@ func (p *TypeScriptParser) Block() (localctx IBlockContext) {
 	p.SetState(795)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 79, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(794)
 			p.StatementList()
@@ -12662,6 +13096,13 @@ func (p *TypeScriptParser) Block() (localctx IBlockContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -12689,13 +13130,13 @@ type StatementListContext struct {
 
 func NewEmptyStatementListContext() *StatementListContext {
 	var p = new(StatementListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_statementList
 	return p
 }
 
 func InitEmptyStatementListContext(p *StatementListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_statementList
 }
 
@@ -12704,7 +13145,7 @@ func (*StatementListContext) IsStatementListContext() {}
 func NewStatementListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatementListContext {
 	var p = new(StatementListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_statementList
@@ -12796,19 +13237,26 @@ func (p *TypeScriptParser) StatementList() (localctx IStatementListContext) {
 			}
 
 		default:
-			p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			goto errorExit
 		}
 
 		p.SetState(802)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 80, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -12839,13 +13287,13 @@ type AbstractDeclarationContext struct {
 
 func NewEmptyAbstractDeclarationContext() *AbstractDeclarationContext {
 	var p = new(AbstractDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_abstractDeclaration
 	return p
 }
 
 func InitEmptyAbstractDeclarationContext(p *AbstractDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_abstractDeclaration
 }
 
@@ -12854,7 +13302,7 @@ func (*AbstractDeclarationContext) IsAbstractDeclarationContext() {}
 func NewAbstractDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AbstractDeclarationContext {
 	var p = new(AbstractDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_abstractDeclaration
@@ -12958,7 +13406,7 @@ func (p *TypeScriptParser) AbstractDeclaration() (localctx IAbstractDeclarationC
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 81, p.GetParserRuleContext()) {
 	case 1:
 		{
 			p.SetState(805)
@@ -12988,6 +13436,13 @@ func (p *TypeScriptParser) AbstractDeclaration() (localctx IAbstractDeclarationC
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -13016,13 +13471,13 @@ type ImportStatementContext struct {
 
 func NewEmptyImportStatementContext() *ImportStatementContext {
 	var p = new(ImportStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_importStatement
 	return p
 }
 
 func InitEmptyImportStatementContext(p *ImportStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_importStatement
 }
 
@@ -13031,7 +13486,7 @@ func (*ImportStatementContext) IsImportStatementContext() {}
 func NewImportStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ImportStatementContext {
 	var p = new(ImportStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_importStatement
@@ -13115,7 +13570,7 @@ func (p *TypeScriptParser) ImportStatement() (localctx IImportStatementContext)
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 82, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 82, p.GetParserRuleContext()) {
 	case 1:
 		{
 			p.SetState(813)
@@ -13133,6 +13588,13 @@ func (p *TypeScriptParser) ImportStatement() (localctx IImportStatementContext)
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -13165,13 +13627,13 @@ type FromBlockContext struct {
 
 func NewEmptyFromBlockContext() *FromBlockContext {
 	var p = new(FromBlockContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_fromBlock
 	return p
 }
 
 func InitEmptyFromBlockContext(p *FromBlockContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_fromBlock
 }
 
@@ -13180,7 +13642,7 @@ func (*FromBlockContext) IsFromBlockContext() {}
 func NewFromBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FromBlockContext {
 	var p = new(FromBlockContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_fromBlock
@@ -13297,14 +13759,14 @@ func (p *TypeScriptParser) FromBlock() (localctx IFromBlockContext) {
 			}
 		}
 
-	case TypeScriptParserOpenBrace, TypeScriptParserNullLiteral, TypeScriptParserBooleanLiteral, TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserp, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule, TypeScriptParserIdentifier:
+	case TypeScriptParserOpenBrace, TypeScriptParserNullLiteral, TypeScriptParserBooleanLiteral, TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserThis, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule, TypeScriptParserIdentifier:
 		{
 			p.SetState(818)
 			p.MultipleImportStatement()
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 	p.SetState(823)
@@ -13351,6 +13813,13 @@ func (p *TypeScriptParser) FromBlock() (localctx IFromBlockContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -13382,13 +13851,13 @@ type MultipleImportStatementContext struct {
 
 func NewEmptyMultipleImportStatementContext() *MultipleImportStatementContext {
 	var p = new(MultipleImportStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_multipleImportStatement
 	return p
 }
 
 func InitEmptyMultipleImportStatementContext(p *MultipleImportStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_multipleImportStatement
 }
 
@@ -13397,7 +13866,7 @@ func (*MultipleImportStatementContext) IsMultipleImportStatementContext() {}
 func NewMultipleImportStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MultipleImportStatementContext {
 	var p = new(MultipleImportStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_multipleImportStatement
@@ -13562,6 +14031,13 @@ func (p *TypeScriptParser) MultipleImportStatement() (localctx IMultipleImportSt
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -13591,13 +14067,13 @@ type ExportStatementContext struct {
 
 func NewEmptyExportStatementContext() *ExportStatementContext {
 	var p = new(ExportStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_exportStatement
 	return p
 }
 
 func InitEmptyExportStatementContext(p *ExportStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_exportStatement
 }
 
@@ -13606,7 +14082,7 @@ func (*ExportStatementContext) IsExportStatementContext() {}
 func NewExportStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExportStatementContext {
 	var p = new(ExportStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_exportStatement
@@ -13691,7 +14167,7 @@ func (p *TypeScriptParser) ExportStatement() (localctx IExportStatementContext)
 	p.SetState(847)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 87, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 87, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(846)
 			p.Match(TypeScriptParserDefault)
@@ -13710,7 +14186,7 @@ func (p *TypeScriptParser) ExportStatement() (localctx IExportStatementContext)
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 88, p.GetParserRuleContext()) {
 	case 1:
 		{
 			p.SetState(849)
@@ -13728,6 +14204,13 @@ func (p *TypeScriptParser) ExportStatement() (localctx IExportStatementContext)
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -13762,13 +14245,13 @@ type VariableStatementContext struct {
 
 func NewEmptyVariableStatementContext() *VariableStatementContext {
 	var p = new(VariableStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_variableStatement
 	return p
 }
 
 func InitEmptyVariableStatementContext(p *VariableStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_variableStatement
 }
 
@@ -13777,7 +14260,7 @@ func (*VariableStatementContext) IsVariableStatementContext() {}
 func NewVariableStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableStatementContext {
 	var p = new(VariableStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_variableStatement
@@ -13926,7 +14409,7 @@ func (p *TypeScriptParser) VariableStatement() (localctx IVariableStatementConte
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 97, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 97, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -13954,7 +14437,7 @@ func (p *TypeScriptParser) VariableStatement() (localctx IVariableStatementConte
 		p.SetState(859)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 90, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 90, p.GetParserRuleContext()) == 1 {
 			{
 				p.SetState(858)
 				p.Match(TypeScriptParserSemiColon)
@@ -14023,7 +14506,7 @@ func (p *TypeScriptParser) VariableStatement() (localctx IVariableStatementConte
 		p.SetState(872)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 94, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 94, p.GetParserRuleContext()) == 1 {
 			{
 				p.SetState(871)
 				p.Match(TypeScriptParserSemiColon)
@@ -14068,7 +14551,7 @@ func (p *TypeScriptParser) VariableStatement() (localctx IVariableStatementConte
 		p.SetState(880)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 96, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 96, p.GetParserRuleContext()) == 1 {
 			{
 				p.SetState(879)
 				p.Match(TypeScriptParserSemiColon)
@@ -14087,6 +14570,13 @@ func (p *TypeScriptParser) VariableStatement() (localctx IVariableStatementConte
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -14116,13 +14606,13 @@ type VariableDeclarationListContext struct {
 
 func NewEmptyVariableDeclarationListContext() *VariableDeclarationListContext {
 	var p = new(VariableDeclarationListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_variableDeclarationList
 	return p
 }
 
 func InitEmptyVariableDeclarationListContext(p *VariableDeclarationListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_variableDeclarationList
 }
 
@@ -14131,7 +14621,7 @@ func (*VariableDeclarationListContext) IsVariableDeclarationListContext() {}
 func NewVariableDeclarationListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableDeclarationListContext {
 	var p = new(VariableDeclarationListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_variableDeclarationList
@@ -14225,7 +14715,7 @@ func (p *TypeScriptParser) VariableDeclarationList() (localctx IVariableDeclarat
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 98, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 98, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -14250,13 +14740,20 @@ func (p *TypeScriptParser) VariableDeclarationList() (localctx IVariableDeclarat
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 98, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 98, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -14290,13 +14787,13 @@ type VariableDeclarationContext struct {
 
 func NewEmptyVariableDeclarationContext() *VariableDeclarationContext {
 	var p = new(VariableDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_variableDeclaration
 	return p
 }
 
 func InitEmptyVariableDeclarationContext(p *VariableDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_variableDeclaration
 }
 
@@ -14305,7 +14802,7 @@ func (*VariableDeclarationContext) IsVariableDeclarationContext() {}
 func NewVariableDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariableDeclarationContext {
 	var p = new(VariableDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_variableDeclaration
@@ -14490,13 +14987,13 @@ func (p *TypeScriptParser) VariableDeclaration() (localctx IVariableDeclarationC
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 	p.SetState(898)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 100, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 100, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(897)
 			p.TypeAnnotation()
@@ -14508,7 +15005,7 @@ func (p *TypeScriptParser) VariableDeclaration() (localctx IVariableDeclarationC
 	p.SetState(901)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 101, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 101, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(900)
 			p.singleExpression(0)
@@ -14520,7 +15017,7 @@ func (p *TypeScriptParser) VariableDeclaration() (localctx IVariableDeclarationC
 	p.SetState(908)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 103, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 103, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(903)
 			p.Match(TypeScriptParserAssign)
@@ -14532,7 +15029,7 @@ func (p *TypeScriptParser) VariableDeclaration() (localctx IVariableDeclarationC
 		p.SetState(905)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 102, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 102, p.GetParserRuleContext()) == 1 {
 			{
 				p.SetState(904)
 				p.TypeParameters()
@@ -14551,6 +15048,13 @@ func (p *TypeScriptParser) VariableDeclaration() (localctx IVariableDeclarationC
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -14577,13 +15081,13 @@ type EmptyStatement_Context struct {
 
 func NewEmptyEmptyStatement_Context() *EmptyStatement_Context {
 	var p = new(EmptyStatement_Context)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_emptyStatement_
 	return p
 }
 
 func InitEmptyEmptyStatement_Context(p *EmptyStatement_Context) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_emptyStatement_
 }
 
@@ -14592,7 +15096,7 @@ func (*EmptyStatement_Context) IsEmptyStatement_Context() {}
 func NewEmptyStatement_Context(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EmptyStatement_Context {
 	var p = new(EmptyStatement_Context)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_emptyStatement_
@@ -14640,6 +15144,13 @@ func (p *TypeScriptParser) EmptyStatement_() (localctx IEmptyStatement_Context)
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -14667,13 +15178,13 @@ type ExpressionStatementContext struct {
 
 func NewEmptyExpressionStatementContext() *ExpressionStatementContext {
 	var p = new(ExpressionStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_expressionStatement
 	return p
 }
 
 func InitEmptyExpressionStatementContext(p *ExpressionStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_expressionStatement
 }
 
@@ -14682,7 +15193,7 @@ func (*ExpressionStatementContext) IsExpressionStatementContext() {}
 func NewExpressionStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionStatementContext {
 	var p = new(ExpressionStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_expressionStatement
@@ -14749,7 +15260,7 @@ func (p *TypeScriptParser) ExpressionStatement() (localctx IExpressionStatementC
 	p.SetState(915)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 104, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 104, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(914)
 			p.Match(TypeScriptParserSemiColon)
@@ -14764,6 +15275,13 @@ func (p *TypeScriptParser) ExpressionStatement() (localctx IExpressionStatementC
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -14796,13 +15314,13 @@ type IfStatementContext struct {
 
 func NewEmptyIfStatementContext() *IfStatementContext {
 	var p = new(IfStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_ifStatement
 	return p
 }
 
 func InitEmptyIfStatementContext(p *IfStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_ifStatement
 }
 
@@ -14811,7 +15329,7 @@ func (*IfStatementContext) IsIfStatementContext() {}
 func NewIfStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfStatementContext {
 	var p = new(IfStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_ifStatement
@@ -14953,7 +15471,7 @@ func (p *TypeScriptParser) IfStatement() (localctx IIfStatementContext) {
 	p.SetState(924)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 105, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 105, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(922)
 			p.Match(TypeScriptParserElse)
@@ -14972,6 +15490,13 @@ func (p *TypeScriptParser) IfStatement() (localctx IIfStatementContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -14994,13 +15519,13 @@ type IterationStatementContext struct {
 
 func NewEmptyIterationStatementContext() *IterationStatementContext {
 	var p = new(IterationStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_iterationStatement
 	return p
 }
 
 func InitEmptyIterationStatementContext(p *IterationStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_iterationStatement
 }
 
@@ -15009,7 +15534,7 @@ func (*IterationStatementContext) IsIterationStatementContext() {}
 func NewIterationStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IterationStatementContext {
 	var p = new(IterationStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_iterationStatement
@@ -15668,7 +16193,7 @@ func (p *TypeScriptParser) IterationStatement() (localctx IIterationStatementCon
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 113, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 113, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewDoStatementContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
@@ -15978,13 +16503,13 @@ func (p *TypeScriptParser) IterationStatement() (localctx IIterationStatementCon
 			}
 			p.SetState(975)
 
-			if !(true) {
+			if !(p.p("of")) {
 				p.SetError(antlr.NewFailedPredicateException(p, "p.p(\"of\")", ""))
 				goto errorExit
 			}
 
 		default:
-			p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			goto errorExit
 		}
 		{
@@ -16059,13 +16584,13 @@ func (p *TypeScriptParser) IterationStatement() (localctx IIterationStatementCon
 			}
 			p.SetState(988)
 
-			if !(true) {
+			if !(p.p("of")) {
 				p.SetError(antlr.NewFailedPredicateException(p, "p.p(\"of\")", ""))
 				goto errorExit
 			}
 
 		default:
-			p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			goto errorExit
 		}
 		{
@@ -16090,6 +16615,13 @@ func (p *TypeScriptParser) IterationStatement() (localctx IIterationStatementCon
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -16118,13 +16650,13 @@ type VarModifierContext struct {
 
 func NewEmptyVarModifierContext() *VarModifierContext {
 	var p = new(VarModifierContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_varModifier
 	return p
 }
 
 func InitEmptyVarModifierContext(p *VarModifierContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_varModifier
 }
 
@@ -16133,7 +16665,7 @@ func (*VarModifierContext) IsVarModifierContext() {}
 func NewVarModifierContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VarModifierContext {
 	var p = new(VarModifierContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_varModifier
@@ -16194,6 +16726,13 @@ func (p *TypeScriptParser) VarModifier() (localctx IVarModifierContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -16222,13 +16761,13 @@ type ContinueStatementContext struct {
 
 func NewEmptyContinueStatementContext() *ContinueStatementContext {
 	var p = new(ContinueStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_continueStatement
 	return p
 }
 
 func InitEmptyContinueStatementContext(p *ContinueStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_continueStatement
 }
 
@@ -16237,7 +16776,7 @@ func (*ContinueStatementContext) IsContinueStatementContext() {}
 func NewContinueStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ContinueStatementContext {
 	var p = new(ContinueStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_continueStatement
@@ -16306,11 +16845,11 @@ func (p *TypeScriptParser) ContinueStatement() (localctx IContinueStatementConte
 	p.SetState(1002)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 114, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 114, p.GetParserRuleContext()) == 1 {
 		p.SetState(1000)
 
-		if !(p.NotLineTerminator()) {
-			p.SetError(antlr.NewFailedPredicateException(p, "p.NotLineTerminator()", ""))
+		if !(p.notLineTerminator()) {
+			p.SetError(antlr.NewFailedPredicateException(p, "p.notLineTerminator()", ""))
 			goto errorExit
 		}
 		{
@@ -16331,6 +16870,13 @@ func (p *TypeScriptParser) ContinueStatement() (localctx IContinueStatementConte
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -16359,13 +16905,13 @@ type BreakStatementContext struct {
 
 func NewEmptyBreakStatementContext() *BreakStatementContext {
 	var p = new(BreakStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_breakStatement
 	return p
 }
 
 func InitEmptyBreakStatementContext(p *BreakStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_breakStatement
 }
 
@@ -16374,7 +16920,7 @@ func (*BreakStatementContext) IsBreakStatementContext() {}
 func NewBreakStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BreakStatementContext {
 	var p = new(BreakStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_breakStatement
@@ -16443,11 +16989,11 @@ func (p *TypeScriptParser) BreakStatement() (localctx IBreakStatementContext) {
 	p.SetState(1009)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 115, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 115, p.GetParserRuleContext()) == 1 {
 		p.SetState(1007)
 
-		if !(p.NotLineTerminator()) {
-			p.SetError(antlr.NewFailedPredicateException(p, "p.NotLineTerminator()", ""))
+		if !(p.notLineTerminator()) {
+			p.SetError(antlr.NewFailedPredicateException(p, "p.notLineTerminator()", ""))
 			goto errorExit
 		}
 		{
@@ -16468,6 +17014,13 @@ func (p *TypeScriptParser) BreakStatement() (localctx IBreakStatementContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -16496,13 +17049,13 @@ type ReturnStatementContext struct {
 
 func NewEmptyReturnStatementContext() *ReturnStatementContext {
 	var p = new(ReturnStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_returnStatement
 	return p
 }
 
 func InitEmptyReturnStatementContext(p *ReturnStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_returnStatement
 }
 
@@ -16511,7 +17064,7 @@ func (*ReturnStatementContext) IsReturnStatementContext() {}
 func NewReturnStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnStatementContext {
 	var p = new(ReturnStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_returnStatement
@@ -16592,11 +17145,11 @@ func (p *TypeScriptParser) ReturnStatement() (localctx IReturnStatementContext)
 	p.SetState(1016)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 116, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 116, p.GetParserRuleContext()) == 1 {
 		p.SetState(1014)
 
-		if !(p.NotLineTerminator()) {
-			p.SetError(antlr.NewFailedPredicateException(p, "p.NotLineTerminator()", ""))
+		if !(p.notLineTerminator()) {
+			p.SetError(antlr.NewFailedPredicateException(p, "p.notLineTerminator()", ""))
 			goto errorExit
 		}
 		{
@@ -16613,6 +17166,13 @@ func (p *TypeScriptParser) ReturnStatement() (localctx IReturnStatementContext)
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -16641,13 +17201,13 @@ type YieldStatementContext struct {
 
 func NewEmptyYieldStatementContext() *YieldStatementContext {
 	var p = new(YieldStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_yieldStatement
 	return p
 }
 
 func InitEmptyYieldStatementContext(p *YieldStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_yieldStatement
 }
 
@@ -16656,7 +17216,7 @@ func (*YieldStatementContext) IsYieldStatementContext() {}
 func NewYieldStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *YieldStatementContext {
 	var p = new(YieldStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_yieldStatement
@@ -16737,11 +17297,11 @@ func (p *TypeScriptParser) YieldStatement() (localctx IYieldStatementContext) {
 	p.SetState(1023)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 117, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 117, p.GetParserRuleContext()) == 1 {
 		p.SetState(1021)
 
-		if !(p.NotLineTerminator()) {
-			p.SetError(antlr.NewFailedPredicateException(p, "p.NotLineTerminator()", ""))
+		if !(p.notLineTerminator()) {
+			p.SetError(antlr.NewFailedPredicateException(p, "p.notLineTerminator()", ""))
 			goto errorExit
 		}
 		{
@@ -16758,6 +17318,13 @@ func (p *TypeScriptParser) YieldStatement() (localctx IYieldStatementContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -16788,13 +17355,13 @@ type WithStatementContext struct {
 
 func NewEmptyWithStatementContext() *WithStatementContext {
 	var p = new(WithStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_withStatement
 	return p
 }
 
 func InitEmptyWithStatementContext(p *WithStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_withStatement
 }
 
@@ -16803,7 +17370,7 @@ func (*WithStatementContext) IsWithStatementContext() {}
 func NewWithStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithStatementContext {
 	var p = new(WithStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_withStatement
@@ -16915,6 +17482,13 @@ func (p *TypeScriptParser) WithStatement() (localctx IWithStatementContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -16945,13 +17519,13 @@ type SwitchStatementContext struct {
 
 func NewEmptySwitchStatementContext() *SwitchStatementContext {
 	var p = new(SwitchStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_switchStatement
 	return p
 }
 
 func InitEmptySwitchStatementContext(p *SwitchStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_switchStatement
 }
 
@@ -16960,7 +17534,7 @@ func (*SwitchStatementContext) IsSwitchStatementContext() {}
 func NewSwitchStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchStatementContext {
 	var p = new(SwitchStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_switchStatement
@@ -17072,6 +17646,13 @@ func (p *TypeScriptParser) SwitchStatement() (localctx ISwitchStatementContext)
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -17102,13 +17683,13 @@ type CaseBlockContext struct {
 
 func NewEmptyCaseBlockContext() *CaseBlockContext {
 	var p = new(CaseBlockContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_caseBlock
 	return p
 }
 
 func InitEmptyCaseBlockContext(p *CaseBlockContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_caseBlock
 }
 
@@ -17117,7 +17698,7 @@ func (*CaseBlockContext) IsCaseBlockContext() {}
 func NewCaseBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CaseBlockContext {
 	var p = new(CaseBlockContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_caseBlock
@@ -17278,6 +17859,13 @@ func (p *TypeScriptParser) CaseBlock() (localctx ICaseBlockContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -17305,13 +17893,13 @@ type CaseClausesContext struct {
 
 func NewEmptyCaseClausesContext() *CaseClausesContext {
 	var p = new(CaseClausesContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_caseClauses
 	return p
 }
 
 func InitEmptyCaseClausesContext(p *CaseClausesContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_caseClauses
 }
 
@@ -17320,7 +17908,7 @@ func (*CaseClausesContext) IsCaseClausesContext() {}
 func NewCaseClausesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CaseClausesContext {
 	var p = new(CaseClausesContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_caseClauses
@@ -17419,6 +18007,13 @@ func (p *TypeScriptParser) CaseClauses() (localctx ICaseClausesContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -17448,13 +18043,13 @@ type CaseClauseContext struct {
 
 func NewEmptyCaseClauseContext() *CaseClauseContext {
 	var p = new(CaseClauseContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_caseClause
 	return p
 }
 
 func InitEmptyCaseClauseContext(p *CaseClauseContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_caseClause
 }
 
@@ -17463,7 +18058,7 @@ func (*CaseClauseContext) IsCaseClauseContext() {}
 func NewCaseClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CaseClauseContext {
 	var p = new(CaseClauseContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_caseClause
@@ -17560,7 +18155,7 @@ func (p *TypeScriptParser) CaseClause() (localctx ICaseClauseContext) {
 	p.SetState(1060)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 122, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 122, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(1059)
 			p.StatementList()
@@ -17571,6 +18166,13 @@ func (p *TypeScriptParser) CaseClause() (localctx ICaseClauseContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -17599,13 +18201,13 @@ type DefaultClauseContext struct {
 
 func NewEmptyDefaultClauseContext() *DefaultClauseContext {
 	var p = new(DefaultClauseContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_defaultClause
 	return p
 }
 
 func InitEmptyDefaultClauseContext(p *DefaultClauseContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_defaultClause
 }
 
@@ -17614,7 +18216,7 @@ func (*DefaultClauseContext) IsDefaultClauseContext() {}
 func NewDefaultClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DefaultClauseContext {
 	var p = new(DefaultClauseContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_defaultClause
@@ -17691,7 +18293,7 @@ func (p *TypeScriptParser) DefaultClause() (localctx IDefaultClauseContext) {
 	p.SetState(1065)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 123, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 123, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(1064)
 			p.StatementList()
@@ -17702,6 +18304,13 @@ func (p *TypeScriptParser) DefaultClause() (localctx IDefaultClauseContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -17730,13 +18339,13 @@ type LabelledStatementContext struct {
 
 func NewEmptyLabelledStatementContext() *LabelledStatementContext {
 	var p = new(LabelledStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_labelledStatement
 	return p
 }
 
 func InitEmptyLabelledStatementContext(p *LabelledStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_labelledStatement
 }
 
@@ -17745,7 +18354,7 @@ func (*LabelledStatementContext) IsLabelledStatementContext() {}
 func NewLabelledStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LabelledStatementContext {
 	var p = new(LabelledStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_labelledStatement
@@ -17825,6 +18434,13 @@ func (p *TypeScriptParser) LabelledStatement() (localctx ILabelledStatementConte
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -17853,13 +18469,13 @@ type ThrowStatementContext struct {
 
 func NewEmptyThrowStatementContext() *ThrowStatementContext {
 	var p = new(ThrowStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_throwStatement
 	return p
 }
 
 func InitEmptyThrowStatementContext(p *ThrowStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_throwStatement
 }
 
@@ -17868,7 +18484,7 @@ func (*ThrowStatementContext) IsThrowStatementContext() {}
 func NewThrowStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ThrowStatementContext {
 	var p = new(ThrowStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_throwStatement
@@ -17948,8 +18564,8 @@ func (p *TypeScriptParser) ThrowStatement() (localctx IThrowStatementContext) {
 	}
 	p.SetState(1072)
 
-	if !(p.NotLineTerminator()) {
-		p.SetError(antlr.NewFailedPredicateException(p, "p.NotLineTerminator()", ""))
+	if !(p.notLineTerminator()) {
+		p.SetError(antlr.NewFailedPredicateException(p, "p.notLineTerminator()", ""))
 		goto errorExit
 	}
 	{
@@ -17962,6 +18578,13 @@ func (p *TypeScriptParser) ThrowStatement() (localctx IThrowStatementContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -17991,13 +18614,13 @@ type TryStatementContext struct {
 
 func NewEmptyTryStatementContext() *TryStatementContext {
 	var p = new(TryStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_tryStatement
 	return p
 }
 
 func InitEmptyTryStatementContext(p *TryStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_tryStatement
 }
 
@@ -18006,7 +18629,7 @@ func (*TryStatementContext) IsTryStatementContext() {}
 func NewTryStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TryStatementContext {
 	var p = new(TryStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_tryStatement
@@ -18119,7 +18742,7 @@ func (p *TypeScriptParser) TryStatement() (localctx ITryStatementContext) {
 		p.SetState(1080)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 124, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 124, p.GetParserRuleContext()) == 1 {
 			{
 				p.SetState(1079)
 				p.FinallyProduction()
@@ -18136,11 +18759,18 @@ func (p *TypeScriptParser) TryStatement() (localctx ITryStatementContext) {
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -18171,13 +18801,13 @@ type CatchProductionContext struct {
 
 func NewEmptyCatchProductionContext() *CatchProductionContext {
 	var p = new(CatchProductionContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_catchProduction
 	return p
 }
 
 func InitEmptyCatchProductionContext(p *CatchProductionContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_catchProduction
 }
 
@@ -18186,7 +18816,7 @@ func (*CatchProductionContext) IsCatchProductionContext() {}
 func NewCatchProductionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CatchProductionContext {
 	var p = new(CatchProductionContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_catchProduction
@@ -18290,6 +18920,13 @@ func (p *TypeScriptParser) CatchProduction() (localctx ICatchProductionContext)
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -18317,13 +18954,13 @@ type FinallyProductionContext struct {
 
 func NewEmptyFinallyProductionContext() *FinallyProductionContext {
 	var p = new(FinallyProductionContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_finallyProduction
 	return p
 }
 
 func InitEmptyFinallyProductionContext(p *FinallyProductionContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_finallyProduction
 }
 
@@ -18332,7 +18969,7 @@ func (*FinallyProductionContext) IsFinallyProductionContext() {}
 func NewFinallyProductionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FinallyProductionContext {
 	var p = new(FinallyProductionContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_finallyProduction
@@ -18400,6 +19037,13 @@ func (p *TypeScriptParser) FinallyProduction() (localctx IFinallyProductionConte
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -18427,13 +19071,13 @@ type DebuggerStatementContext struct {
 
 func NewEmptyDebuggerStatementContext() *DebuggerStatementContext {
 	var p = new(DebuggerStatementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_debuggerStatement
 	return p
 }
 
 func InitEmptyDebuggerStatementContext(p *DebuggerStatementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_debuggerStatement
 }
 
@@ -18442,7 +19086,7 @@ func (*DebuggerStatementContext) IsDebuggerStatementContext() {}
 func NewDebuggerStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DebuggerStatementContext {
 	var p = new(DebuggerStatementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_debuggerStatement
@@ -18510,6 +19154,13 @@ func (p *TypeScriptParser) DebuggerStatement() (localctx IDebuggerStatementConte
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -18542,13 +19193,13 @@ type FunctionDeclarationContext struct {
 
 func NewEmptyFunctionDeclarationContext() *FunctionDeclarationContext {
 	var p = new(FunctionDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_functionDeclaration
 	return p
 }
 
 func InitEmptyFunctionDeclarationContext(p *FunctionDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_functionDeclaration
 }
 
@@ -18557,7 +19208,7 @@ func (*FunctionDeclarationContext) IsFunctionDeclarationContext() {}
 func NewFunctionDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionDeclarationContext {
 	var p = new(FunctionDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_functionDeclaration
@@ -18703,11 +19354,18 @@ func (p *TypeScriptParser) FunctionDeclaration() (localctx IFunctionDeclarationC
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -18742,13 +19400,13 @@ type ClassDeclarationContext struct {
 
 func NewEmptyClassDeclarationContext() *ClassDeclarationContext {
 	var p = new(ClassDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classDeclaration
 	return p
 }
 
 func InitEmptyClassDeclarationContext(p *ClassDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classDeclaration
 }
 
@@ -18757,7 +19415,7 @@ func (*ClassDeclarationContext) IsClassDeclarationContext() {}
 func NewClassDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClassDeclarationContext {
 	var p = new(ClassDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_classDeclaration
@@ -18985,6 +19643,13 @@ func (p *TypeScriptParser) ClassDeclaration() (localctx IClassDeclarationContext
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -19012,13 +19677,13 @@ type ClassHeritageContext struct {
 
 func NewEmptyClassHeritageContext() *ClassHeritageContext {
 	var p = new(ClassHeritageContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classHeritage
 	return p
 }
 
 func InitEmptyClassHeritageContext(p *ClassHeritageContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classHeritage
 }
 
@@ -19027,7 +19692,7 @@ func (*ClassHeritageContext) IsClassHeritageContext() {}
 func NewClassHeritageContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClassHeritageContext {
 	var p = new(ClassHeritageContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_classHeritage
@@ -19125,6 +19790,13 @@ func (p *TypeScriptParser) ClassHeritage() (localctx IClassHeritageContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -19154,13 +19826,13 @@ type ClassTailContext struct {
 
 func NewEmptyClassTailContext() *ClassTailContext {
 	var p = new(ClassTailContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classTail
 	return p
 }
 
 func InitEmptyClassTailContext(p *ClassTailContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classTail
 }
 
@@ -19169,7 +19841,7 @@ func (*ClassTailContext) IsClassTailContext() {}
 func NewClassTailContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClassTailContext {
 	var p = new(ClassTailContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_classTail
@@ -19267,7 +19939,7 @@ func (p *TypeScriptParser) ClassTail() (localctx IClassTailContext) {
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 134, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 134, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -19284,7 +19956,7 @@ func (p *TypeScriptParser) ClassTail() (localctx IClassTailContext) {
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 134, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 134, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -19299,6 +19971,13 @@ func (p *TypeScriptParser) ClassTail() (localctx IClassTailContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -19326,13 +20005,13 @@ type ClassExtendsClauseContext struct {
 
 func NewEmptyClassExtendsClauseContext() *ClassExtendsClauseContext {
 	var p = new(ClassExtendsClauseContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classExtendsClause
 	return p
 }
 
 func InitEmptyClassExtendsClauseContext(p *ClassExtendsClauseContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classExtendsClause
 }
 
@@ -19341,7 +20020,7 @@ func (*ClassExtendsClauseContext) IsClassExtendsClauseContext() {}
 func NewClassExtendsClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClassExtendsClauseContext {
 	var p = new(ClassExtendsClauseContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_classExtendsClause
@@ -19409,6 +20088,13 @@ func (p *TypeScriptParser) ClassExtendsClause() (localctx IClassExtendsClauseCon
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -19436,13 +20122,13 @@ type ImplementsClauseContext struct {
 
 func NewEmptyImplementsClauseContext() *ImplementsClauseContext {
 	var p = new(ImplementsClauseContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_implementsClause
 	return p
 }
 
 func InitEmptyImplementsClauseContext(p *ImplementsClauseContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_implementsClause
 }
 
@@ -19451,7 +20137,7 @@ func (*ImplementsClauseContext) IsImplementsClauseContext() {}
 func NewImplementsClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ImplementsClauseContext {
 	var p = new(ImplementsClauseContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_implementsClause
@@ -19519,6 +20205,13 @@ func (p *TypeScriptParser) ImplementsClause() (localctx IImplementsClauseContext
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -19549,13 +20242,13 @@ type ClassElementContext struct {
 
 func NewEmptyClassElementContext() *ClassElementContext {
 	var p = new(ClassElementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classElement
 	return p
 }
 
 func InitEmptyClassElementContext(p *ClassElementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_classElement
 }
 
@@ -19564,7 +20257,7 @@ func (*ClassElementContext) IsClassElementContext() {}
 func NewClassElementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClassElementContext {
 	var p = new(ClassElementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_classElement
@@ -19685,7 +20378,7 @@ func (p *TypeScriptParser) ClassElement() (localctx IClassElementContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 136, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 136, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -19733,6 +20426,13 @@ func (p *TypeScriptParser) ClassElement() (localctx IClassElementContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -19755,13 +20455,13 @@ type PropertyMemberDeclarationContext struct {
 
 func NewEmptyPropertyMemberDeclarationContext() *PropertyMemberDeclarationContext {
 	var p = new(PropertyMemberDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_propertyMemberDeclaration
 	return p
 }
 
 func InitEmptyPropertyMemberDeclarationContext(p *PropertyMemberDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_propertyMemberDeclaration
 }
 
@@ -19770,7 +20470,7 @@ func (*PropertyMemberDeclarationContext) IsPropertyMemberDeclarationContext() {}
 func NewPropertyMemberDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PropertyMemberDeclarationContext {
 	var p = new(PropertyMemberDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_propertyMemberDeclaration
@@ -20135,7 +20835,7 @@ func (p *TypeScriptParser) PropertyMemberDeclaration() (localctx IPropertyMember
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 142, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 142, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewPropertyDeclarationExpressionContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
@@ -20257,7 +20957,7 @@ func (p *TypeScriptParser) PropertyMemberDeclaration() (localctx IPropertyMember
 			}
 
 		default:
-			p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			goto errorExit
 		}
 
@@ -20288,7 +20988,7 @@ func (p *TypeScriptParser) PropertyMemberDeclaration() (localctx IPropertyMember
 			}
 
 		default:
-			p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			goto errorExit
 		}
 
@@ -20305,6 +21005,13 @@ func (p *TypeScriptParser) PropertyMemberDeclaration() (localctx IPropertyMember
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -20334,13 +21041,13 @@ type PropertyMemberBaseContext struct {
 
 func NewEmptyPropertyMemberBaseContext() *PropertyMemberBaseContext {
 	var p = new(PropertyMemberBaseContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_propertyMemberBase
 	return p
 }
 
 func InitEmptyPropertyMemberBaseContext(p *PropertyMemberBaseContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_propertyMemberBase
 }
 
@@ -20349,7 +21056,7 @@ func (*PropertyMemberBaseContext) IsPropertyMemberBaseContext() {}
 func NewPropertyMemberBaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PropertyMemberBaseContext {
 	var p = new(PropertyMemberBaseContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_propertyMemberBase
@@ -20414,7 +21121,7 @@ func (p *TypeScriptParser) PropertyMemberBase() (localctx IPropertyMemberBaseCon
 	p.SetState(1189)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 143, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 143, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(1188)
 			p.AccessibilityModifier()
@@ -20426,7 +21133,7 @@ func (p *TypeScriptParser) PropertyMemberBase() (localctx IPropertyMemberBaseCon
 	p.SetState(1192)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 144, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 144, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(1191)
 			p.Match(TypeScriptParserAsync)
@@ -20442,7 +21149,7 @@ func (p *TypeScriptParser) PropertyMemberBase() (localctx IPropertyMemberBaseCon
 	p.SetState(1195)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 145, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 145, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(1194)
 			p.Match(TypeScriptParserStatic)
@@ -20458,7 +21165,7 @@ func (p *TypeScriptParser) PropertyMemberBase() (localctx IPropertyMemberBaseCon
 	p.SetState(1198)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 146, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 146, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(1197)
 			p.Match(TypeScriptParserReadOnly)
@@ -20473,6 +21180,13 @@ func (p *TypeScriptParser) PropertyMemberBase() (localctx IPropertyMemberBaseCon
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -20500,13 +21214,13 @@ type IndexMemberDeclarationContext struct {
 
 func NewEmptyIndexMemberDeclarationContext() *IndexMemberDeclarationContext {
 	var p = new(IndexMemberDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_indexMemberDeclaration
 	return p
 }
 
 func InitEmptyIndexMemberDeclarationContext(p *IndexMemberDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_indexMemberDeclaration
 }
 
@@ -20515,7 +21229,7 @@ func (*IndexMemberDeclarationContext) IsIndexMemberDeclarationContext() {}
 func NewIndexMemberDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IndexMemberDeclarationContext {
 	var p = new(IndexMemberDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_indexMemberDeclaration
@@ -20583,6 +21297,13 @@ func (p *TypeScriptParser) IndexMemberDeclaration() (localctx IIndexMemberDeclar
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -20616,13 +21337,13 @@ type GeneratorMethodContext struct {
 
 func NewEmptyGeneratorMethodContext() *GeneratorMethodContext {
 	var p = new(GeneratorMethodContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_generatorMethod
 	return p
 }
 
 func InitEmptyGeneratorMethodContext(p *GeneratorMethodContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_generatorMethod
 }
 
@@ -20631,7 +21352,7 @@ func (*GeneratorMethodContext) IsGeneratorMethodContext() {}
 func NewGeneratorMethodContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GeneratorMethodContext {
 	var p = new(GeneratorMethodContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_generatorMethod
@@ -20801,6 +21522,13 @@ func (p *TypeScriptParser) GeneratorMethod() (localctx IGeneratorMethodContext)
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -20835,13 +21563,13 @@ type GeneratorFunctionDeclarationContext struct {
 
 func NewEmptyGeneratorFunctionDeclarationContext() *GeneratorFunctionDeclarationContext {
 	var p = new(GeneratorFunctionDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_generatorFunctionDeclaration
 	return p
 }
 
 func InitEmptyGeneratorFunctionDeclarationContext(p *GeneratorFunctionDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_generatorFunctionDeclaration
 }
 
@@ -20850,7 +21578,7 @@ func (*GeneratorFunctionDeclarationContext) IsGeneratorFunctionDeclarationContex
 func NewGeneratorFunctionDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GeneratorFunctionDeclarationContext {
 	var p = new(GeneratorFunctionDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_generatorFunctionDeclaration
@@ -21032,6 +21760,13 @@ func (p *TypeScriptParser) GeneratorFunctionDeclaration() (localctx IGeneratorFu
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -21063,13 +21798,13 @@ type GeneratorBlockContext struct {
 
 func NewEmptyGeneratorBlockContext() *GeneratorBlockContext {
 	var p = new(GeneratorBlockContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_generatorBlock
 	return p
 }
 
 func InitEmptyGeneratorBlockContext(p *GeneratorBlockContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_generatorBlock
 }
 
@@ -21078,7 +21813,7 @@ func (*GeneratorBlockContext) IsGeneratorBlockContext() {}
 func NewGeneratorBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GeneratorBlockContext {
 	var p = new(GeneratorBlockContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_generatorBlock
@@ -21190,7 +21925,7 @@ func (p *TypeScriptParser) GeneratorBlock() (localctx IGeneratorBlockContext) {
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 151, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 151, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -21215,7 +21950,7 @@ func (p *TypeScriptParser) GeneratorBlock() (localctx IGeneratorBlockContext) {
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 151, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 151, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -21248,6 +21983,13 @@ func (p *TypeScriptParser) GeneratorBlock() (localctx IGeneratorBlockContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -21275,13 +22017,13 @@ type GeneratorDefinitionContext struct {
 
 func NewEmptyGeneratorDefinitionContext() *GeneratorDefinitionContext {
 	var p = new(GeneratorDefinitionContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_generatorDefinition
 	return p
 }
 
 func InitEmptyGeneratorDefinitionContext(p *GeneratorDefinitionContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_generatorDefinition
 }
 
@@ -21290,7 +22032,7 @@ func (*GeneratorDefinitionContext) IsGeneratorDefinitionContext() {}
 func NewGeneratorDefinitionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GeneratorDefinitionContext {
 	var p = new(GeneratorDefinitionContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_generatorDefinition
@@ -21358,6 +22100,13 @@ func (p *TypeScriptParser) GeneratorDefinition() (localctx IGeneratorDefinitionC
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -21389,13 +22138,13 @@ type IteratorBlockContext struct {
 
 func NewEmptyIteratorBlockContext() *IteratorBlockContext {
 	var p = new(IteratorBlockContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_iteratorBlock
 	return p
 }
 
 func InitEmptyIteratorBlockContext(p *IteratorBlockContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_iteratorBlock
 }
 
@@ -21404,7 +22153,7 @@ func (*IteratorBlockContext) IsIteratorBlockContext() {}
 func NewIteratorBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IteratorBlockContext {
 	var p = new(IteratorBlockContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_iteratorBlock
@@ -21516,7 +22265,7 @@ func (p *TypeScriptParser) IteratorBlock() (localctx IIteratorBlockContext) {
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 153, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 153, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -21541,7 +22290,7 @@ func (p *TypeScriptParser) IteratorBlock() (localctx IIteratorBlockContext) {
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 153, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 153, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -21574,6 +22323,13 @@ func (p *TypeScriptParser) IteratorBlock() (localctx IIteratorBlockContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -21608,13 +22364,13 @@ type IteratorDefinitionContext struct {
 
 func NewEmptyIteratorDefinitionContext() *IteratorDefinitionContext {
 	var p = new(IteratorDefinitionContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_iteratorDefinition
 	return p
 }
 
 func InitEmptyIteratorDefinitionContext(p *IteratorDefinitionContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_iteratorDefinition
 }
 
@@ -21623,7 +22379,7 @@ func (*IteratorDefinitionContext) IsIteratorDefinitionContext() {}
 func NewIteratorDefinitionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IteratorDefinitionContext {
 	var p = new(IteratorDefinitionContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_iteratorDefinition
@@ -21803,6 +22559,13 @@ func (p *TypeScriptParser) IteratorDefinition() (localctx IIteratorDefinitionCon
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -21837,13 +22600,13 @@ type FormalParameterListContext struct {
 
 func NewEmptyFormalParameterListContext() *FormalParameterListContext {
 	var p = new(FormalParameterListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_formalParameterList
 	return p
 }
 
 func InitEmptyFormalParameterListContext(p *FormalParameterListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_formalParameterList
 }
 
@@ -21852,7 +22615,7 @@ func (*FormalParameterListContext) IsFormalParameterListContext() {}
 func NewFormalParameterListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FormalParameterListContext {
 	var p = new(FormalParameterListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_formalParameterList
@@ -22024,7 +22787,7 @@ func (p *TypeScriptParser) FormalParameterList() (localctx IFormalParameterListC
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 156, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 156, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -22049,7 +22812,7 @@ func (p *TypeScriptParser) FormalParameterList() (localctx IFormalParameterListC
 			if p.HasError() {
 				goto errorExit
 			}
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 156, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 156, p.GetParserRuleContext())
 			if p.HasError() {
 				goto errorExit
 			}
@@ -22121,11 +22884,18 @@ func (p *TypeScriptParser) FormalParameterList() (localctx IFormalParameterListC
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -22158,13 +22928,13 @@ type FormalParameterArgContext struct {
 
 func NewEmptyFormalParameterArgContext() *FormalParameterArgContext {
 	var p = new(FormalParameterArgContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_formalParameterArg
 	return p
 }
 
 func InitEmptyFormalParameterArgContext(p *FormalParameterArgContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_formalParameterArg
 }
 
@@ -22173,7 +22943,7 @@ func (*FormalParameterArgContext) IsFormalParameterArgContext() {}
 func NewFormalParameterArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FormalParameterArgContext {
 	var p = new(FormalParameterArgContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_formalParameterArg
@@ -22385,6 +23155,13 @@ func (p *TypeScriptParser) FormalParameterArg() (localctx IFormalParameterArgCon
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -22413,13 +23190,13 @@ type LastFormalParameterArgContext struct {
 
 func NewEmptyLastFormalParameterArgContext() *LastFormalParameterArgContext {
 	var p = new(LastFormalParameterArgContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_lastFormalParameterArg
 	return p
 }
 
 func InitEmptyLastFormalParameterArgContext(p *LastFormalParameterArgContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_lastFormalParameterArg
 }
 
@@ -22428,7 +23205,7 @@ func (*LastFormalParameterArgContext) IsLastFormalParameterArgContext() {}
 func NewLastFormalParameterArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LastFormalParameterArgContext {
 	var p = new(LastFormalParameterArgContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_lastFormalParameterArg
@@ -22520,6 +23297,13 @@ func (p *TypeScriptParser) LastFormalParameterArg() (localctx ILastFormalParamet
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -22546,13 +23330,13 @@ type FunctionBodyContext struct {
 
 func NewEmptyFunctionBodyContext() *FunctionBodyContext {
 	var p = new(FunctionBodyContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_functionBody
 	return p
 }
 
 func InitEmptyFunctionBodyContext(p *FunctionBodyContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_functionBody
 }
 
@@ -22561,7 +23345,7 @@ func (*FunctionBodyContext) IsFunctionBodyContext() {}
 func NewFunctionBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionBodyContext {
 	var p = new(FunctionBodyContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_functionBody
@@ -22614,7 +23398,7 @@ func (p *TypeScriptParser) FunctionBody() (localctx IFunctionBodyContext) {
 	p.SetState(1317)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 166, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 166, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(1316)
 			p.SourceElements()
@@ -22625,6 +23409,13 @@ func (p *TypeScriptParser) FunctionBody() (localctx IFunctionBodyContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -22652,13 +23443,13 @@ type SourceElementsContext struct {
 
 func NewEmptySourceElementsContext() *SourceElementsContext {
 	var p = new(SourceElementsContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_sourceElements
 	return p
 }
 
 func InitEmptySourceElementsContext(p *SourceElementsContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_sourceElements
 }
 
@@ -22667,7 +23458,7 @@ func (*SourceElementsContext) IsSourceElementsContext() {}
 func NewSourceElementsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceElementsContext {
 	var p = new(SourceElementsContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_sourceElements
@@ -22759,19 +23550,26 @@ func (p *TypeScriptParser) SourceElements() (localctx ISourceElementsContext) {
 			}
 
 		default:
-			p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			goto errorExit
 		}
 
 		p.SetState(1322)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 167, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 167, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -22800,13 +23598,13 @@ type ArrayLiteralContext struct {
 
 func NewEmptyArrayLiteralContext() *ArrayLiteralContext {
 	var p = new(ArrayLiteralContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrayLiteral
 	return p
 }
 
 func InitEmptyArrayLiteralContext(p *ArrayLiteralContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrayLiteral
 }
 
@@ -22815,7 +23613,7 @@ func (*ArrayLiteralContext) IsArrayLiteralContext() {}
 func NewArrayLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayLiteralContext {
 	var p = new(ArrayLiteralContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_arrayLiteral
@@ -22907,6 +23705,13 @@ func (p *TypeScriptParser) ArrayLiteral() (localctx IArrayLiteralContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -22936,13 +23741,13 @@ type ElementListContext struct {
 
 func NewEmptyElementListContext() *ElementListContext {
 	var p = new(ElementListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_elementList
 	return p
 }
 
 func InitEmptyElementListContext(p *ElementListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_elementList
 }
 
@@ -22951,7 +23756,7 @@ func (*ElementListContext) IsElementListContext() {}
 func NewElementListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElementListContext {
 	var p = new(ElementListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_elementList
@@ -23086,6 +23891,13 @@ func (p *TypeScriptParser) ElementList() (localctx IElementListContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -23115,13 +23927,13 @@ type ArrayElementContext struct {
 
 func NewEmptyArrayElementContext() *ArrayElementContext {
 	var p = new(ArrayElementContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrayElement
 	return p
 }
 
 func InitEmptyArrayElementContext(p *ArrayElementContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrayElement
 }
 
@@ -23130,7 +23942,7 @@ func (*ArrayElementContext) IsArrayElementContext() {}
 func NewArrayElementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayElementContext {
 	var p = new(ArrayElementContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_arrayElement
@@ -23218,7 +24030,7 @@ func (p *TypeScriptParser) ArrayElement() (localctx IArrayElementContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 172, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 172, p.GetParserRuleContext()) {
 	case 1:
 		{
 			p.SetState(1345)
@@ -23241,7 +24053,7 @@ func (p *TypeScriptParser) ArrayElement() (localctx IArrayElementContext) {
 	p.SetState(1350)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 173, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 173, p.GetParserRuleContext()) == 1 {
 		{
 			p.SetState(1349)
 			p.Match(TypeScriptParserComma)
@@ -23256,6 +24068,13 @@ func (p *TypeScriptParser) ArrayElement() (localctx IArrayElementContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -23287,13 +24106,13 @@ type ObjectLiteralContext struct {
 
 func NewEmptyObjectLiteralContext() *ObjectLiteralContext {
 	var p = new(ObjectLiteralContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_objectLiteral
 	return p
 }
 
 func InitEmptyObjectLiteralContext(p *ObjectLiteralContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_objectLiteral
 }
 
@@ -23302,7 +24121,7 @@ func (*ObjectLiteralContext) IsObjectLiteralContext() {}
 func NewObjectLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ObjectLiteralContext {
 	var p = new(ObjectLiteralContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_objectLiteral
@@ -23422,7 +24241,7 @@ func (p *TypeScriptParser) ObjectLiteral() (localctx IObjectLiteralContext) {
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 174, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 174, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
@@ -23447,7 +24266,7 @@ func (p *TypeScriptParser) ObjectLiteral() (localctx IObjectLiteralContext) {
 			if p.HasError() {
 				goto errorExit
 			}
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 174, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 174, p.GetParserRuleContext())
 			if p.HasError() {
 				goto errorExit
 			}
@@ -23482,6 +24301,13 @@ func (p *TypeScriptParser) ObjectLiteral() (localctx IObjectLiteralContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -23504,13 +24330,13 @@ type PropertyAssignmentContext struct {
 
 func NewEmptyPropertyAssignmentContext() *PropertyAssignmentContext {
 	var p = new(PropertyAssignmentContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_propertyAssignment
 	return p
 }
 
 func InitEmptyPropertyAssignmentContext(p *PropertyAssignmentContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_propertyAssignment
 }
 
@@ -23519,7 +24345,7 @@ func (*PropertyAssignmentContext) IsPropertyAssignmentContext() {}
 func NewPropertyAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PropertyAssignmentContext {
 	var p = new(PropertyAssignmentContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_propertyAssignment
@@ -23935,7 +24761,7 @@ func (p *TypeScriptParser) PropertyAssignment() (localctx IPropertyAssignmentCon
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 177, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 177, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewPropertyExpressionAssignmentContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
@@ -24040,6 +24866,13 @@ func (p *TypeScriptParser) PropertyAssignment() (localctx IPropertyAssignmentCon
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -24072,13 +24905,13 @@ type GetAccessorContext struct {
 
 func NewEmptyGetAccessorContext() *GetAccessorContext {
 	var p = new(GetAccessorContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_getAccessor
 	return p
 }
 
 func InitEmptyGetAccessorContext(p *GetAccessorContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_getAccessor
 }
 
@@ -24087,7 +24920,7 @@ func (*GetAccessorContext) IsGetAccessorContext() {}
 func NewGetAccessorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GetAccessorContext {
 	var p = new(GetAccessorContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_getAccessor
@@ -24243,6 +25076,13 @@ func (p *TypeScriptParser) GetAccessor() (localctx IGetAccessorContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -24277,13 +25117,13 @@ type SetAccessorContext struct {
 
 func NewEmptySetAccessorContext() *SetAccessorContext {
 	var p = new(SetAccessorContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_setAccessor
 	return p
 }
 
 func InitEmptySetAccessorContext(p *SetAccessorContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_setAccessor
 }
 
@@ -24292,7 +25132,7 @@ func (*SetAccessorContext) IsSetAccessorContext() {}
 func NewSetAccessorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SetAccessorContext {
 	var p = new(SetAccessorContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_setAccessor
@@ -24448,7 +25288,7 @@ func (p *TypeScriptParser) SetAccessor() (localctx ISetAccessorContext) {
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 	p.SetState(1402)
@@ -24495,6 +25335,13 @@ func (p *TypeScriptParser) SetAccessor() (localctx ISetAccessorContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -24523,13 +25370,13 @@ type PropertyNameContext struct {
 
 func NewEmptyPropertyNameContext() *PropertyNameContext {
 	var p = new(PropertyNameContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_propertyName
 	return p
 }
 
 func InitEmptyPropertyNameContext(p *PropertyNameContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_propertyName
 }
 
@@ -24538,7 +25385,7 @@ func (*PropertyNameContext) IsPropertyNameContext() {}
 func NewPropertyNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PropertyNameContext {
 	var p = new(PropertyNameContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_propertyName
@@ -24614,7 +25461,7 @@ func (p *TypeScriptParser) PropertyName() (localctx IPropertyNameContext) {
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case TypeScriptParserNullLiteral, TypeScriptParserBooleanLiteral, TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserp, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule, TypeScriptParserIdentifier:
+	case TypeScriptParserNullLiteral, TypeScriptParserBooleanLiteral, TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserThis, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule, TypeScriptParserIdentifier:
 		p.EnterOuterAlt(localctx, 1)
 		{
 			p.SetState(1409)
@@ -24640,11 +25487,18 @@ func (p *TypeScriptParser) PropertyName() (localctx IPropertyNameContext) {
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -24674,13 +25528,13 @@ type ArgumentsContext struct {
 
 func NewEmptyArgumentsContext() *ArgumentsContext {
 	var p = new(ArgumentsContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arguments
 	return p
 }
 
 func InitEmptyArgumentsContext(p *ArgumentsContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arguments
 }
 
@@ -24689,7 +25543,7 @@ func (*ArgumentsContext) IsArgumentsContext() {}
 func NewArgumentsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentsContext {
 	var p = new(ArgumentsContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_arguments
@@ -24803,6 +25657,13 @@ func (p *TypeScriptParser) Arguments() (localctx IArgumentsContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -24832,13 +25693,13 @@ type ArgumentListContext struct {
 
 func NewEmptyArgumentListContext() *ArgumentListContext {
 	var p = new(ArgumentListContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_argumentList
 	return p
 }
 
 func InitEmptyArgumentListContext(p *ArgumentListContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_argumentList
 }
 
@@ -24847,7 +25708,7 @@ func (*ArgumentListContext) IsArgumentListContext() {}
 func NewArgumentListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentListContext {
 	var p = new(ArgumentListContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_argumentList
@@ -24941,7 +25802,7 @@ func (p *TypeScriptParser) ArgumentList() (localctx IArgumentListContext) {
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 184, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 184, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -24966,13 +25827,20 @@ func (p *TypeScriptParser) ArgumentList() (localctx IArgumentListContext) {
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 184, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 184, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -25001,13 +25869,13 @@ type ArgumentContext struct {
 
 func NewEmptyArgumentContext() *ArgumentContext {
 	var p = new(ArgumentContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_argument
 	return p
 }
 
 func InitEmptyArgumentContext(p *ArgumentContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_argument
 }
 
@@ -25016,7 +25884,7 @@ func (*ArgumentContext) IsArgumentContext() {}
 func NewArgumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentContext {
 	var p = new(ArgumentContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_argument
@@ -25100,7 +25968,7 @@ func (p *TypeScriptParser) Argument() (localctx IArgumentContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 186, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 186, p.GetParserRuleContext()) {
 	case 1:
 		{
 			p.SetState(1434)
@@ -25122,6 +25990,13 @@ func (p *TypeScriptParser) Argument() (localctx IArgumentContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -25151,13 +26026,13 @@ type ExpressionSequenceContext struct {
 
 func NewEmptyExpressionSequenceContext() *ExpressionSequenceContext {
 	var p = new(ExpressionSequenceContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_expressionSequence
 	return p
 }
 
 func InitEmptyExpressionSequenceContext(p *ExpressionSequenceContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_expressionSequence
 }
 
@@ -25166,7 +26041,7 @@ func (*ExpressionSequenceContext) IsExpressionSequenceContext() {}
 func NewExpressionSequenceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionSequenceContext {
 	var p = new(ExpressionSequenceContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_expressionSequence
@@ -25260,7 +26135,7 @@ func (p *TypeScriptParser) ExpressionSequence() (localctx IExpressionSequenceCon
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 187, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 187, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -25285,13 +26160,20 @@ func (p *TypeScriptParser) ExpressionSequence() (localctx IExpressionSequenceCon
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 187, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 187, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -25326,13 +26208,13 @@ type FunctionExpressionDeclarationContext struct {
 
 func NewEmptyFunctionExpressionDeclarationContext() *FunctionExpressionDeclarationContext {
 	var p = new(FunctionExpressionDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_functionExpressionDeclaration
 	return p
 }
 
 func InitEmptyFunctionExpressionDeclarationContext(p *FunctionExpressionDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_functionExpressionDeclaration
 }
 
@@ -25341,7 +26223,7 @@ func (*FunctionExpressionDeclarationContext) IsFunctionExpressionDeclarationCont
 func NewFunctionExpressionDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionExpressionDeclarationContext {
 	var p = new(FunctionExpressionDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_functionExpressionDeclaration
@@ -25541,6 +26423,13 @@ func (p *TypeScriptParser) FunctionExpressionDeclaration() (localctx IFunctionEx
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -25563,13 +26452,13 @@ type SingleExpressionContext struct {
 
 func NewEmptySingleExpressionContext() *SingleExpressionContext {
 	var p = new(SingleExpressionContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_singleExpression
 	return p
 }
 
 func InitEmptySingleExpressionContext(p *SingleExpressionContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_singleExpression
 }
 
@@ -25578,7 +26467,7 @@ func (*SingleExpressionContext) IsSingleExpressionContext() {}
 func NewSingleExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SingleExpressionContext {
 	var p = new(SingleExpressionContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_singleExpression
@@ -26332,12 +27221,12 @@ func (s *ArgumentsExpressionContext) ExitRule(listener antlr.ParseTreeListener)
 	}
 }
 
-type pExpressionContext struct {
+type ThisExpressionContext struct {
 	SingleExpressionContext
 }
 
-func NewpExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *pExpressionContext {
-	var p = new(pExpressionContext)
+func NewThisExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ThisExpressionContext {
+	var p = new(ThisExpressionContext)
 
 	InitEmptySingleExpressionContext(&p.SingleExpressionContext)
 	p.parser = parser
@@ -26346,23 +27235,23 @@ func NewpExpressionContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *pE
 	return p
 }
 
-func (s *pExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *ThisExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *pExpressionContext) p() antlr.TerminalNode {
-	return s.GetToken(TypeScriptParserp, 0)
+func (s *ThisExpressionContext) This() antlr.TerminalNode {
+	return s.GetToken(TypeScriptParserThis, 0)
 }
 
-func (s *pExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ThisExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TypeScriptParserListener); ok {
-		listenerT.EnterpExpression(s)
+		listenerT.EnterThisExpression(s)
 	}
 }
 
-func (s *pExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ThisExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(TypeScriptParserListener); ok {
-		listenerT.ExitpExpression(s)
+		listenerT.ExitThisExpression(s)
 	}
 }
 
@@ -28447,7 +29336,7 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 195, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 195, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewFunctionExpressionContext(p, localctx)
 		p.SetParserRuleContext(localctx)
@@ -28521,7 +29410,7 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 		p.SetState(1475)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 192, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 192, p.GetParserRuleContext()) == 1 {
 			{
 				p.SetState(1474)
 				p.TypeArguments()
@@ -28721,12 +29610,12 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 		}
 
 	case 18:
-		localctx = NewpExpressionContext(p, localctx)
+		localctx = NewThisExpressionContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
 			p.SetState(1499)
-			p.Match(TypeScriptParserp)
+			p.Match(TypeScriptParserThis)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
@@ -28744,7 +29633,11 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 		p.SetState(1502)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 193, p.GetParserRuleContext()) == 1 {
+		// Edited by hand, the grammar this was generated from is not kept: a name followed by ( or [
+		// is a call or an index, which the loop below reads as ArgumentsExpression and MemberIndexExpression.
+		// Taking it here read foo(x) as the name foo followed by the expression (x)
+		if _la = p.GetTokenStream().LA(1); _la != TypeScriptParserOpenParen && _la != TypeScriptParserOpenBracket &&
+			p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 193, p.GetParserRuleContext()) == 1 {
 			{
 				p.SetState(1501)
 				p.singleExpression(0)
@@ -28830,7 +29723,7 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 		p.SetState(1514)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 194, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 194, p.GetParserRuleContext()) == 1 {
 			{
 				p.SetState(1513)
 				p.ExpressionSequence()
@@ -28849,7 +29742,7 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 	if p.HasError() {
 		goto errorExit
 	}
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 199, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 199, p.GetParserRuleContext())
 	if p.HasError() {
 		goto errorExit
 	}
@@ -28865,7 +29758,7 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 				goto errorExit
 			}
 
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 198, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 198, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewMultiplicativeExpressionContext(p, NewSingleExpressionContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, TypeScriptParserRULE_singleExpression)
@@ -29291,7 +30184,7 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 				p.SetState(1579)
 				p.GetErrorHandler().Sync(p)
 
-				if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 197, p.GetParserRuleContext()) == 1 {
+				if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 197, p.GetParserRuleContext()) == 1 {
 					{
 						p.SetState(1578)
 						p.NestedTypeGeneric()
@@ -29326,8 +30219,8 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 				}
 				p.SetState(1584)
 
-				if !(p.NotLineTerminator()) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.NotLineTerminator()", ""))
+				if !(p.notLineTerminator()) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.notLineTerminator()", ""))
 					goto errorExit
 				}
 				{
@@ -29350,8 +30243,8 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 				}
 				p.SetState(1587)
 
-				if !(p.NotLineTerminator()) {
-					p.SetError(antlr.NewFailedPredicateException(p, "p.NotLineTerminator()", ""))
+				if !(p.notLineTerminator()) {
+					p.SetError(antlr.NewFailedPredicateException(p, "p.notLineTerminator()", ""))
 					goto errorExit
 				}
 				{
@@ -29409,13 +30302,20 @@ func (p *TypeScriptParser) singleExpression(_p int) (localctx ISingleExpressionC
 		if p.HasError() {
 			goto errorExit
 		}
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 199, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 199, p.GetParserRuleContext())
 		if p.HasError() {
 			goto errorExit
 		}
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.UnrollRecursionContexts(_parentctx)
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -29445,13 +30345,13 @@ type AsExpressionContext struct {
 
 func NewEmptyAsExpressionContext() *AsExpressionContext {
 	var p = new(AsExpressionContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_asExpression
 	return p
 }
 
 func InitEmptyAsExpressionContext(p *AsExpressionContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_asExpression
 }
 
@@ -29460,7 +30360,7 @@ func (*AsExpressionContext) IsAsExpressionContext() {}
 func NewAsExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AsExpressionContext {
 	var p = new(AsExpressionContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_asExpression
@@ -29539,7 +30439,7 @@ func (p *TypeScriptParser) AsExpression() (localctx IAsExpressionContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 201, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 201, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -29549,7 +30449,7 @@ func (p *TypeScriptParser) AsExpression() (localctx IAsExpressionContext) {
 		p.SetState(1602)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 200, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 200, p.GetParserRuleContext()) == 1 {
 			{
 				p.SetState(1600)
 				p.Match(TypeScriptParserOpenBracket)
@@ -29583,6 +30483,13 @@ func (p *TypeScriptParser) AsExpression() (localctx IAsExpressionContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -29613,13 +30520,13 @@ type ArrowFunctionDeclarationContext struct {
 
 func NewEmptyArrowFunctionDeclarationContext() *ArrowFunctionDeclarationContext {
 	var p = new(ArrowFunctionDeclarationContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrowFunctionDeclaration
 	return p
 }
 
 func InitEmptyArrowFunctionDeclarationContext(p *ArrowFunctionDeclarationContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrowFunctionDeclaration
 }
 
@@ -29628,7 +30535,7 @@ func (*ArrowFunctionDeclarationContext) IsArrowFunctionDeclarationContext() {}
 func NewArrowFunctionDeclarationContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrowFunctionDeclarationContext {
 	var p = new(ArrowFunctionDeclarationContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_arrowFunctionDeclaration
@@ -29770,6 +30677,13 @@ func (p *TypeScriptParser) ArrowFunctionDeclaration() (localctx IArrowFunctionDe
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -29799,13 +30713,13 @@ type ArrowFunctionParametersContext struct {
 
 func NewEmptyArrowFunctionParametersContext() *ArrowFunctionParametersContext {
 	var p = new(ArrowFunctionParametersContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrowFunctionParameters
 	return p
 }
 
 func InitEmptyArrowFunctionParametersContext(p *ArrowFunctionParametersContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrowFunctionParameters
 }
 
@@ -29814,7 +30728,7 @@ func (*ArrowFunctionParametersContext) IsArrowFunctionParametersContext() {}
 func NewArrowFunctionParametersContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrowFunctionParametersContext {
 	var p = new(ArrowFunctionParametersContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_arrowFunctionParameters
@@ -29929,11 +30843,18 @@ func (p *TypeScriptParser) ArrowFunctionParameters() (localctx IArrowFunctionPar
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -29963,13 +30884,13 @@ type ArrowFunctionBodyContext struct {
 
 func NewEmptyArrowFunctionBodyContext() *ArrowFunctionBodyContext {
 	var p = new(ArrowFunctionBodyContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrowFunctionBody
 	return p
 }
 
 func InitEmptyArrowFunctionBodyContext(p *ArrowFunctionBodyContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_arrowFunctionBody
 }
 
@@ -29978,7 +30899,7 @@ func (*ArrowFunctionBodyContext) IsArrowFunctionBodyContext() {}
 func NewArrowFunctionBodyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrowFunctionBodyContext {
 	var p = new(ArrowFunctionBodyContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_arrowFunctionBody
@@ -30057,7 +30978,7 @@ func (p *TypeScriptParser) ArrowFunctionBody() (localctx IArrowFunctionBodyConte
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 206, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 206, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -30093,6 +31014,13 @@ func (p *TypeScriptParser) ArrowFunctionBody() (localctx IArrowFunctionBodyConte
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -30129,13 +31057,13 @@ type AssignmentOperatorContext struct {
 
 func NewEmptyAssignmentOperatorContext() *AssignmentOperatorContext {
 	var p = new(AssignmentOperatorContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_assignmentOperator
 	return p
 }
 
 func InitEmptyAssignmentOperatorContext(p *AssignmentOperatorContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_assignmentOperator
 }
 
@@ -30144,7 +31072,7 @@ func (*AssignmentOperatorContext) IsAssignmentOperatorContext() {}
 func NewAssignmentOperatorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AssignmentOperatorContext {
 	var p = new(AssignmentOperatorContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_assignmentOperator
@@ -30237,6 +31165,13 @@ func (p *TypeScriptParser) AssignmentOperator() (localctx IAssignmentOperatorCon
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -30268,13 +31203,13 @@ type LiteralContext struct {
 
 func NewEmptyLiteralContext() *LiteralContext {
 	var p = new(LiteralContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_literal
 	return p
 }
 
 func InitEmptyLiteralContext(p *LiteralContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_literal
 }
 
@@ -30283,7 +31218,7 @@ func (*LiteralContext) IsLiteralContext() {}
 func NewLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LiteralContext {
 	var p = new(LiteralContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_literal
@@ -30430,11 +31365,18 @@ func (p *TypeScriptParser) Literal() (localctx ILiteralContext) {
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -30464,13 +31406,13 @@ type TemplateStringLiteralContext struct {
 
 func NewEmptyTemplateStringLiteralContext() *TemplateStringLiteralContext {
 	var p = new(TemplateStringLiteralContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_templateStringLiteral
 	return p
 }
 
 func InitEmptyTemplateStringLiteralContext(p *TemplateStringLiteralContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_templateStringLiteral
 }
 
@@ -30479,7 +31421,7 @@ func (*TemplateStringLiteralContext) IsTemplateStringLiteralContext() {}
 func NewTemplateStringLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TemplateStringLiteralContext {
 	var p = new(TemplateStringLiteralContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_templateStringLiteral
@@ -30602,6 +31544,13 @@ func (p *TypeScriptParser) TemplateStringLiteral() (localctx ITemplateStringLite
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -30632,13 +31581,13 @@ type TemplateStringAtomContext struct {
 
 func NewEmptyTemplateStringAtomContext() *TemplateStringAtomContext {
 	var p = new(TemplateStringAtomContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_templateStringAtom
 	return p
 }
 
 func InitEmptyTemplateStringAtomContext(p *TemplateStringAtomContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_templateStringAtom
 }
 
@@ -30647,7 +31596,7 @@ func (*TemplateStringAtomContext) IsTemplateStringAtomContext() {}
 func NewTemplateStringAtomContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TemplateStringAtomContext {
 	var p = new(TemplateStringAtomContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_templateStringAtom
@@ -30765,11 +31714,18 @@ func (p *TypeScriptParser) TemplateStringAtom() (localctx ITemplateStringAtomCon
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -30800,13 +31756,13 @@ type NumericLiteralContext struct {
 
 func NewEmptyNumericLiteralContext() *NumericLiteralContext {
 	var p = new(NumericLiteralContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_numericLiteral
 	return p
 }
 
 func InitEmptyNumericLiteralContext(p *NumericLiteralContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_numericLiteral
 }
 
@@ -30815,7 +31771,7 @@ func (*NumericLiteralContext) IsNumericLiteralContext() {}
 func NewNumericLiteralContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NumericLiteralContext {
 	var p = new(NumericLiteralContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_numericLiteral
@@ -30884,6 +31840,13 @@ func (p *TypeScriptParser) NumericLiteral() (localctx INumericLiteralContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -30911,13 +31874,13 @@ type IdentifierNameContext struct {
 
 func NewEmptyIdentifierNameContext() *IdentifierNameContext {
 	var p = new(IdentifierNameContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_identifierName
 	return p
 }
 
 func InitEmptyIdentifierNameContext(p *IdentifierNameContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_identifierName
 }
 
@@ -30926,7 +31889,7 @@ func (*IdentifierNameContext) IsIdentifierNameContext() {}
 func NewIdentifierNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierNameContext {
 	var p = new(IdentifierNameContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_identifierName
@@ -30997,7 +31960,7 @@ func (p *TypeScriptParser) IdentifierName() (localctx IIdentifierNameContext) {
 			}
 		}
 
-	case TypeScriptParserNullLiteral, TypeScriptParserBooleanLiteral, TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserp, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule:
+	case TypeScriptParserNullLiteral, TypeScriptParserBooleanLiteral, TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserThis, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule:
 		p.EnterOuterAlt(localctx, 2)
 		{
 			p.SetState(1662)
@@ -31005,11 +31968,18 @@ func (p *TypeScriptParser) IdentifierName() (localctx IIdentifierNameContext) {
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -31038,13 +32008,13 @@ type IdentifierOrKeyWordContext struct {
 
 func NewEmptyIdentifierOrKeyWordContext() *IdentifierOrKeyWordContext {
 	var p = new(IdentifierOrKeyWordContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_identifierOrKeyWord
 	return p
 }
 
 func InitEmptyIdentifierOrKeyWordContext(p *IdentifierOrKeyWordContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_identifierOrKeyWord
 }
 
@@ -31053,7 +32023,7 @@ func (*IdentifierOrKeyWordContext) IsIdentifierOrKeyWordContext() {}
 func NewIdentifierOrKeyWordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierOrKeyWordContext {
 	var p = new(IdentifierOrKeyWordContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_identifierOrKeyWord
@@ -31114,6 +32084,13 @@ func (p *TypeScriptParser) IdentifierOrKeyWord() (localctx IIdentifierOrKeyWordC
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -31142,13 +32119,13 @@ type ReservedWordContext struct {
 
 func NewEmptyReservedWordContext() *ReservedWordContext {
 	var p = new(ReservedWordContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_reservedWord
 	return p
 }
 
 func InitEmptyReservedWordContext(p *ReservedWordContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_reservedWord
 }
 
@@ -31157,7 +32134,7 @@ func (*ReservedWordContext) IsReservedWordContext() {}
 func NewReservedWordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReservedWordContext {
 	var p = new(ReservedWordContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_reservedWord
@@ -31221,7 +32198,7 @@ func (p *TypeScriptParser) ReservedWord() (localctx IReservedWordContext) {
 	}
 
 	switch p.GetTokenStream().LA(1) {
-	case TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserp, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule:
+	case TypeScriptParserBreak, TypeScriptParserDo, TypeScriptParserInstanceof, TypeScriptParserTypeof, TypeScriptParserCase, TypeScriptParserElse, TypeScriptParserNew, TypeScriptParserVar, TypeScriptParserCatch, TypeScriptParserFinally, TypeScriptParserReturn, TypeScriptParserVoid, TypeScriptParserContinue, TypeScriptParserFor, TypeScriptParserSwitch, TypeScriptParserWhile, TypeScriptParserDebugger, TypeScriptParserFunction_, TypeScriptParserThis, TypeScriptParserWith, TypeScriptParserDefault, TypeScriptParserIf, TypeScriptParserThrow, TypeScriptParserDelete, TypeScriptParserIn, TypeScriptParserTry, TypeScriptParserFrom, TypeScriptParserReadOnly, TypeScriptParserAsync, TypeScriptParserClass, TypeScriptParserEnum, TypeScriptParserExtends, TypeScriptParserSuper, TypeScriptParserConst, TypeScriptParserExport, TypeScriptParserImport, TypeScriptParserImplements, TypeScriptParserLet, TypeScriptParserPrivate, TypeScriptParserPublic, TypeScriptParserInterface, TypeScriptParserPackage, TypeScriptParserProtected, TypeScriptParserStatic, TypeScriptParserYield, TypeScriptParserNumber, TypeScriptParserBoolean, TypeScriptParserString_, TypeScriptParserTypeAlias, TypeScriptParserGet, TypeScriptParserSet, TypeScriptParserRequire, TypeScriptParserModule:
 		p.EnterOuterAlt(localctx, 1)
 		{
 			p.SetState(1667)
@@ -31251,11 +32228,18 @@ func (p *TypeScriptParser) ReservedWord() (localctx IReservedWordContext) {
 		}
 
 	default:
-		p.setError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -31287,7 +32271,7 @@ type IKeywordContext interface {
 	While() antlr.TerminalNode
 	Debugger() antlr.TerminalNode
 	Function_() antlr.TerminalNode
-	p() antlr.TerminalNode
+	This() antlr.TerminalNode
 	With() antlr.TerminalNode
 	Default() antlr.TerminalNode
 	If() antlr.TerminalNode
@@ -31334,13 +32318,13 @@ type KeywordContext struct {
 
 func NewEmptyKeywordContext() *KeywordContext {
 	var p = new(KeywordContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_keyword
 	return p
 }
 
 func InitEmptyKeywordContext(p *KeywordContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_keyword
 }
 
@@ -31349,7 +32333,7 @@ func (*KeywordContext) IsKeywordContext() {}
 func NewKeywordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *KeywordContext {
 	var p = new(KeywordContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_keyword
@@ -31431,8 +32415,8 @@ func (s *KeywordContext) Function_() antlr.TerminalNode {
 	return s.GetToken(TypeScriptParserFunction_, 0)
 }
 
-func (s *KeywordContext) p() antlr.TerminalNode {
-	return s.GetToken(TypeScriptParserp, 0)
+func (s *KeywordContext) This() antlr.TerminalNode {
+	return s.GetToken(TypeScriptParserThis, 0)
 }
 
 func (s *KeywordContext) With() antlr.TerminalNode {
@@ -31610,6 +32594,13 @@ func (p *TypeScriptParser) Keyword() (localctx IKeywordContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -31637,13 +32628,13 @@ type GetterContext struct {
 
 func NewEmptyGetterContext() *GetterContext {
 	var p = new(GetterContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_getter
 	return p
 }
 
 func InitEmptyGetterContext(p *GetterContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_getter
 }
 
@@ -31652,7 +32643,7 @@ func (*GetterContext) IsGetterContext() {}
 func NewGetterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GetterContext {
 	var p = new(GetterContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_getter
@@ -31720,6 +32711,13 @@ func (p *TypeScriptParser) Getter() (localctx IGetterContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -31747,13 +32745,13 @@ type SetterContext struct {
 
 func NewEmptySetterContext() *SetterContext {
 	var p = new(SetterContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_setter
 	return p
 }
 
 func InitEmptySetterContext(p *SetterContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_setter
 }
 
@@ -31762,7 +32760,7 @@ func (*SetterContext) IsSetterContext() {}
 func NewSetterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SetterContext {
 	var p = new(SetterContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_setter
@@ -31830,6 +32828,13 @@ func (p *TypeScriptParser) Setter() (localctx ISetterContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -31857,13 +32862,13 @@ type EosContext struct {
 
 func NewEmptyEosContext() *EosContext {
 	var p = new(EosContext)
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_eos
 	return p
 }
 
 func InitEmptyEosContext(p *EosContext) {
-	antlr.NewBaseParserRuleContext(nil, -1)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
 	p.RuleIndex = TypeScriptParserRULE_eos
 }
 
@@ -31872,7 +32877,7 @@ func (*EosContext) IsEosContext() {}
 func NewEosContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EosContext {
 	var p = new(EosContext)
 
-	antlr.NewBaseParserRuleContext(parent, invokingState)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
 
 	p.parser = parser
 	p.RuleIndex = TypeScriptParserRULE_eos
@@ -31919,7 +32924,7 @@ func (p *TypeScriptParser) Eos() (localctx IEosContext) {
 		goto errorExit
 	}
 
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 212, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 212, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
@@ -31965,6 +32970,13 @@ func (p *TypeScriptParser) Eos() (localctx IEosContext) {
 	}
 
 errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
 	p.ExitRule()
 	return localctx
 	goto errorExit // Trick to prevent compiler error if the label is not used
@@ -32087,7 +33099,7 @@ func (p *TypeScriptParser) PrimaryType_Sempred(localctx antlr.RuleContext, predI
 		return p.Precpred(p.GetParserRuleContext(), 5)
 
 	case 3:
-		return notLineTerminator()
+		return p.notLineTerminator()
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -32097,7 +33109,7 @@ func (p *TypeScriptParser) PrimaryType_Sempred(localctx antlr.RuleContext, predI
 func (p *TypeScriptParser) ArrayType_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 4:
-		return notLineTerminator()
+		return p.notLineTerminator()
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -32127,10 +33139,10 @@ func (p *TypeScriptParser) ExpressionStatement_Sempred(localctx antlr.RuleContex
 func (p *TypeScriptParser) IterationStatement_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 7:
-		return true
+		return p.p("of")
 
 	case 8:
-		return true
+		return p.p("of")
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -32140,7 +33152,7 @@ func (p *TypeScriptParser) IterationStatement_Sempred(localctx antlr.RuleContext
 func (p *TypeScriptParser) ContinueStatement_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 9:
-		return p.NotLineTerminator()
+		return p.notLineTerminator()
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -32150,7 +33162,7 @@ func (p *TypeScriptParser) ContinueStatement_Sempred(localctx antlr.RuleContext,
 func (p *TypeScriptParser) BreakStatement_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 10:
-		return p.NotLineTerminator()
+		return p.notLineTerminator()
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -32160,7 +33172,7 @@ func (p *TypeScriptParser) BreakStatement_Sempred(localctx antlr.RuleContext, pr
 func (p *TypeScriptParser) ReturnStatement_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 11:
-		return p.NotLineTerminator()
+		return p.notLineTerminator()
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -32170,7 +33182,7 @@ func (p *TypeScriptParser) ReturnStatement_Sempred(localctx antlr.RuleContext, p
 func (p *TypeScriptParser) YieldStatement_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 12:
-		return p.NotLineTerminator()
+		return p.notLineTerminator()
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -32180,7 +33192,7 @@ func (p *TypeScriptParser) YieldStatement_Sempred(localctx antlr.RuleContext, pr
 func (p *TypeScriptParser) ThrowStatement_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 13:
-		return p.NotLineTerminator()
+		return p.notLineTerminator()
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
@@ -32247,13 +33259,13 @@ func (p *TypeScriptParser) SingleExpression_Sempred(localctx antlr.RuleContext,
 		return p.Precpred(p.GetParserRuleContext(), 40)
 
 	case 33:
-		return p.NotLineTerminator()
+		return p.notLineTerminator()
 
 	case 34:
 		return p.Precpred(p.GetParserRuleContext(), 39)
 
 	case 35:
-		return p.NotLineTerminator()
+		return p.notLineTerminator()
 
 	case 36:
 		return p.Precpred(p.GetParserRuleContext(), 14)
@@ -32278,25 +33290,3 @@ func (p *TypeScriptParser) Eos_Sempred(localctx antlr.RuleContext, predIndex int
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
 	}
 }
-
-func (p *TypeScriptParser) HasError() bool{ return false }
-
-func (p *TypeScriptParser) setError(n *antlr.NoViableAltException) { }
-
-func (p *TypeScriptParser) SetError(f *antlr.FailedPredicateException) {}
-
-func (p *TypeScriptParser) NotLineTerminator() bool { return false }
-
-func (p *TypeScriptParser) notOpenBraceAndNotFunction() bool { return true
-
-}
-
-func (p *TypeScriptParser) lineTerminatorAhead() bool { return true
-
-}
-
-func (p *TypeScriptParser) closeBrace() bool { return true
-
-}
-
-func notLineTerminator() bool { return false }
\ No newline at end of file
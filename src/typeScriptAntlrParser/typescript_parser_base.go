@@ -0,0 +1,84 @@
+package parser
+
+import (
+	"strings"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// TypeScriptParserBase
+// The superClass of TypeScriptParser.g4, holding the predicates the grammar calls.
+// They look at the hidden tokens around the current one, which is how the grammar
+// knows about line terminators for automatic semicolon insertion
+type TypeScriptParserBase struct {
+	*antlr.BaseParser
+}
+
+// p
+// Checks if the previous token is str, as in for (const x of items)
+func (b *TypeScriptParserBase) p(str string) bool {
+	previous := b.GetTokenStream().LT(-1)
+	return previous != nil && previous.GetText() == str
+}
+
+// notLineTerminator
+// Checks that there is no line break right before the current token,
+// so that return\nvalue is two statements
+func (b *TypeScriptParserBase) notLineTerminator() bool {
+	return !b.here(TypeScriptParserLineTerminator)
+}
+
+// notOpenBraceAndNotFunction
+// An expression statement cannot start with { or function, those are blocks and declarations
+func (b *TypeScriptParserBase) notOpenBraceAndNotFunction() bool {
+	next := b.GetTokenStream().LT(1).GetTokenType()
+	return next != TypeScriptParserOpenBrace && next != TypeScriptParserFunction_
+}
+
+// closeBrace
+// Checks if the next token closes a block, which also ends a statement
+func (b *TypeScriptParserBase) closeBrace() bool {
+	return b.GetTokenStream().LT(1).GetTokenType() == TypeScriptParserCloseBrace
+}
+
+// lineTerminatorAhead
+// Checks if there is a line break between the current token and the one before it,
+// either on its own, after whitespace, or inside a multi line comment
+func (b *TypeScriptParserBase) lineTerminatorAhead() bool {
+	index := b.GetCurrentToken().GetTokenIndex() - 1
+	if index < 0 {
+		return false
+	}
+	ahead := b.GetTokenStream().Get(index)
+	if ahead.GetChannel() != antlr.LexerHidden {
+		return false
+	}
+	if ahead.GetTokenType() == TypeScriptParserLineTerminator {
+		return true
+	}
+	if ahead.GetTokenType() == TypeScriptParserWhiteSpaces {
+		index--
+		if index < 0 {
+			return false
+		}
+		ahead = b.GetTokenStream().Get(index)
+	}
+	switch ahead.GetTokenType() {
+	case TypeScriptParserLineTerminator:
+		return true
+	case TypeScriptParserMultiLineComment:
+		return strings.ContainsAny(ahead.GetText(), "\r\n")
+	}
+	return false
+}
+
+// here
+// Checks if the hidden token right before the current one is of the given type
+func (b *TypeScriptParserBase) here(tokenType int) bool {
+	index := b.GetCurrentToken().GetTokenIndex() - 1
+	if index < 0 {
+		return false
+	}
+	ahead := b.GetTokenStream().Get(index)
+	return ahead.GetChannel() == antlr.LexerHidden && ahead.GetTokenType() == tokenType
+}
@@ -2,31 +2,11 @@
 
 package parser // TypeScriptParser
 
-import "github.com/antlr/antlr4/runtime/Go/antlr/v4"
+import "github.com/antlr4-go/antlr/v4"
 
 // BaseTypeScriptParserListener is a complete listener for a parse tree produced by TypeScriptParser.
 type BaseTypeScriptParserListener struct{}
 
-func (s2 *BaseTypeScriptParserListener) ExitpPrimType(s *pPrimTypeContext) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s2 *BaseTypeScriptParserListener) EnterpPrimType(s *pPrimTypeContext) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s2 *BaseTypeScriptParserListener) EnterpExpression(s *pExpressionContext) {
-	//TODO implement me
-	panic("implement me")
-}
-
-func (s2 *BaseTypeScriptParserListener) ExitpExpression(s *pExpressionContext) {
-	//TODO implement me
-	panic("implement me")
-}
-
 var _ TypeScriptParserListener = &BaseTypeScriptParserListener{}
 
 // VisitTerminal is called when a terminal node is visited.
@@ -145,10 +125,10 @@ func (s *BaseTypeScriptParserListener) EnterParenthesizedPrimType(ctx *Parenthes
 func (s *BaseTypeScriptParserListener) ExitParenthesizedPrimType(ctx *ParenthesizedPrimTypeContext) {}
 
 // EnterThisPrimType is called when production ThisPrimType is entered.
-//func (s *BaseTypeScriptParserListener) EnterThisPrimType(ctx *ThisPrimTypeContext) {}
+func (s *BaseTypeScriptParserListener) EnterThisPrimType(ctx *ThisPrimTypeContext) {}
 
 // ExitThisPrimType is called when production ThisPrimType is exited.
-//func (s *BaseTypeScriptParserListener) ExitThisPrimType(ctx *ThisPrimTypeContext) {}
+func (s *BaseTypeScriptParserListener) ExitThisPrimType(ctx *ThisPrimTypeContext) {}
 
 // EnterTuplePrimType is called when production TuplePrimType is entered.
 func (s *BaseTypeScriptParserListener) EnterTuplePrimType(ctx *TuplePrimTypeContext) {}
@@ -1067,10 +1047,10 @@ func (s *BaseTypeScriptParserListener) EnterArgumentsExpression(ctx *ArgumentsEx
 func (s *BaseTypeScriptParserListener) ExitArgumentsExpression(ctx *ArgumentsExpressionContext) {}
 
 // EnterThisExpression is called when production ThisExpression is entered.
-//func (s *BaseTypeScriptParserListener) EnterThisExpression(ctx *ThisExpressionContext) {}
+func (s *BaseTypeScriptParserListener) EnterThisExpression(ctx *ThisExpressionContext) {}
 
 // ExitThisExpression is called when production ThisExpression is exited.
-//func (s *BaseTypeScriptParserListener) ExitThisExpression(ctx *ThisExpressionContext) {}
+func (s *BaseTypeScriptParserListener) ExitThisExpression(ctx *ThisExpressionContext) {}
 
 // EnterFunctionExpression is called when production FunctionExpression is entered.
 func (s *BaseTypeScriptParserListener) EnterFunctionExpression(ctx *FunctionExpressionContext) {}
@@ -2,7 +2,7 @@
 
 package parser // TypeScriptParser
 
-import "github.com/antlr/antlr4/runtime/Go/antlr/v4"
+import "github.com/antlr4-go/antlr/v4"
 
 // TypeScriptParserListener is a complete listener for a parse tree produced by TypeScriptParser.
 type TypeScriptParserListener interface {
@@ -60,7 +60,7 @@ type TypeScriptParserListener interface {
 	EnterParenthesizedPrimType(c *ParenthesizedPrimTypeContext)
 
 	// EnterThisPrimType is called when entering the ThisPrimType production.
-	//EnterThisPrimType(c *ThisPrimTypeContext)
+	EnterThisPrimType(c *ThisPrimTypeContext)
 
 	// EnterTuplePrimType is called when entering the TuplePrimType production.
 	EnterTuplePrimType(c *TuplePrimTypeContext)
@@ -498,7 +498,7 @@ type TypeScriptParserListener interface {
 	EnterArgumentsExpression(c *ArgumentsExpressionContext)
 
 	// EnterThisExpression is called when entering the ThisExpression production.
-	//EnterThisExpression(c *ThisExpressionContext)
+	EnterThisExpression(c *ThisExpressionContext)
 
 	// EnterFunctionExpression is called when entering the FunctionExpression production.
 	EnterFunctionExpression(c *FunctionExpressionContext)
@@ -699,7 +699,7 @@ type TypeScriptParserListener interface {
 	ExitParenthesizedPrimType(c *ParenthesizedPrimTypeContext)
 
 	// ExitThisPrimType is called when exiting the ThisPrimType production.
-	//ExitThisPrimType(c *ThisPrimTypeContext)
+	ExitThisPrimType(c *ThisPrimTypeContext)
 
 	// ExitTuplePrimType is called when exiting the TuplePrimType production.
 	ExitTuplePrimType(c *TuplePrimTypeContext)
@@ -1137,7 +1137,7 @@ type TypeScriptParserListener interface {
 	ExitArgumentsExpression(c *ArgumentsExpressionContext)
 
 	// ExitThisExpression is called when exiting the ThisExpression production.
-	//ExitThisExpression(c *ThisExpressionContext)
+	ExitThisExpression(c *ThisExpressionContext)
 
 	// ExitFunctionExpression is called when exiting the FunctionExpression production.
 	ExitFunctionExpression(c *FunctionExpressionContext)
@@ -1285,9 +1285,4 @@ type TypeScriptParserListener interface {
 
 	// ExitEos is called when exiting the eos production.
 	ExitEos(c *EosContext)
-
-	ExitpPrimType(s *pPrimTypeContext)
-	EnterpPrimType(s *pPrimTypeContext)
-	EnterpExpression(s *pExpressionContext)
-	ExitpExpression(s *pExpressionContext)
 }
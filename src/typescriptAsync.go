@@ -0,0 +1,134 @@
+package typescript
+
+import (
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+	parser "SubmissionGrader/internal/complexity/typescript/typeScriptAntlrParser"
+	"fmt"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// asyncTracker
+// What is known about the asynchronous code of one method while it is being walked
+type asyncTracker struct {
+	info          methodInfoType.AsyncInfo
+	loopDepth     int // loops of this method we are in, loops of the method around it do not count
+	callbackDepth int // 0 when the method is not a callback
+}
+
+// EnterVoidExpression
+// await is rewritten into void before lexing (see rewriteModernSyntax), so this is where awaits are found
+func (l *typescriptComplexityListener) EnterVoidExpression(ctx *parser.VoidExpressionContext) {
+	if !l.currentState.InMethod || ctx.Void() == nil || !l.awaits[ctx.Void().GetSymbol().GetStart()] {
+		return
+	}
+	frame := l.getCurrentMethodFrame()
+	if frame == nil {
+		return
+	}
+	frame.async.info.AwaitCount++
+	if frame.async.loopDepth > 0 {
+		l.addAsyncIssue(frame, ctx.GetStart().GetLine(), methodInfoType.AsyncIssueAwaitInLoop, "await inside a loop runs one pass at a time")
+	}
+}
+
+// EnterExpressionStatement
+// A promise made by a statement on its own is thrown away, so nothing sees it being rejected
+// unless the statement handles the rejection itself
+func (l *typescriptComplexityListener) EnterExpressionStatement(ctx *parser.ExpressionStatementContext) {
+	if !l.currentState.InMethod {
+		return
+	}
+	frame := l.getCurrentMethodFrame()
+	call, ok := getStatementCall(ctx)
+	if frame == nil || !ok {
+		return
+	}
+	line := ctx.GetStart().GetLine()
+	if length, handled := getPromiseChain(call); length > 0 {
+		if !handled {
+			l.addAsyncIssue(frame, line, methodInfoType.AsyncIssueMissingCatch, "promise chain has no .catch and is not returned or awaited")
+		}
+		return
+	}
+	if name := getCalledName(call); l.asyncNames[name] {
+		l.addAsyncIssue(frame, line, methodInfoType.AsyncIssueNotAwaited, fmt.Sprintf("async %s is called without await", name))
+	}
+}
+
+// addPromiseLink
+// Each .then, .catch and .finally is a jump in the flow of the method, like a catch clause
+func (l *typescriptComplexityListener) addPromiseLink(ctx *parser.ArgumentsExpressionContext) {
+	l.currentState.IncCogCount(1)
+	frame := l.getCurrentMethodFrame()
+	if frame == nil {
+		return
+	}
+	if length, _ := getPromiseChain(ctx); length > frame.async.info.LongestPromiseChain {
+		frame.async.info.LongestPromiseChain = length
+	}
+}
+
+// enterLoop
+// Loops are scoped items that also keep track of awaits made inside them
+func (l *typescriptComplexityListener) enterLoop() {
+	l.enterScopedItem(1)
+	if frame := l.getCurrentMethodFrame(); frame != nil && l.currentState.InMethod {
+		frame.async.loopDepth++
+	}
+}
+
+func (l *typescriptComplexityListener) exitLoop() {
+	l.exitScopedItem()
+	if frame := l.getCurrentMethodFrame(); frame != nil && l.currentState.InMethod {
+		frame.async.loopDepth--
+	}
+}
+
+// newAsyncTracker
+// A callback passed inside another callback is one level deeper than it
+func (l *typescriptComplexityListener) newAsyncTracker(ctx antlr.ParserRuleContext) asyncTracker {
+	tracker := asyncTracker{info: methodInfoType.AsyncInfo{IsAsync: l.isAsyncFunction(ctx)}}
+	if !isCallback(ctx) {
+		return tracker
+	}
+	tracker.callbackDepth = 1
+	if frame := l.getCurrentMethodFrame(); frame != nil && l.currentState.InMethod {
+		tracker.callbackDepth += frame.async.callbackDepth
+	}
+	tracker.info.MaxCallbackNesting = tracker.callbackDepth
+	return tracker
+}
+
+// isAsyncFunction
+// Looks at the tokens before the parameters for async, or for where rewriteModernSyntax took it out
+func (l *typescriptComplexityListener) isAsyncFunction(ctx antlr.ParserRuleContext) bool {
+	if arrow, ok := ctx.(*parser.ArrowFunctionDeclarationContext); ok {
+		return arrow.Async() != nil
+	}
+	found, _ := l.findAsyncToken(ctx)
+	return found
+}
+
+// findAsyncToken
+// done is true once the parameters (or type parameters) have been reached
+func (l *typescriptComplexityListener) findAsyncToken(tree antlr.Tree) (found bool, done bool) {
+	if terminal, ok := tree.(antlr.TerminalNode); ok {
+		symbol := terminal.GetSymbol()
+		switch symbol.GetTokenType() {
+		case parser.TypeScriptParserOpenParen, parser.TypeScriptParserOpenBrace, parser.TypeScriptParserLessThan:
+			return false, true
+		}
+		return symbol.GetTokenType() == parser.TypeScriptParserAsync || l.asyncFunctions[symbol.GetStart()], false
+	}
+	for i := 0; i < tree.GetChildCount(); i++ {
+		if found, done := l.findAsyncToken(tree.GetChild(i)); found || done {
+			return found, done
+		}
+	}
+	return false, false
+}
+
+func (l *typescriptComplexityListener) addAsyncIssue(frame *functionFrame, line int, kind string, message string) {
+	frame.async.info.Issues = append(frame.async.info.Issues, methodInfoType.AsyncIssue{Line: line, Kind: kind, Message: message})
+}
@@ -0,0 +1,228 @@
+package typescript
+
+import (
+	parser "SubmissionGrader/internal/complexity/typescript/typeScriptAntlrParser"
+	"context"
+	"fmt"
+	"runtime/metrics"
+	"time"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// parseLimits
+// How long parsing one file may take and how much it may grow the heap.
+// Prediction in the generated parser can take exponential time and memory on some broken files,
+// so a parse that goes past either is abandoned instead of holding up the whole submission
+var parseLimits = struct {
+	timeout    time.Duration
+	heapGrowth uint64
+	// how many times the parser looks at a token between checks of the limits
+	checkPeriod int
+}{
+	timeout:     10 * time.Second,
+	heapGrowth:  1 << 30,
+	checkPeriod: 4096,
+}
+
+// parseAbandoned
+// Why a parse went past parseLimits, and the token it had got to
+type parseAbandoned struct {
+	reason string
+	token  antlr.Token
+}
+
+func (a parseAbandoned) Error() string {
+	return fmt.Sprintf("parsing was abandoned at line %d: %s", a.token.GetLine(), a.reason)
+}
+
+// parseBailed
+// What bailErrorStrategy panics with at the first syntax error
+type parseBailed struct{}
+
+// boundedTokenStream
+// Checks the deadline and the heap every checkPeriod times the parser looks at a token,
+// panicking with parseAbandoned once one of them is passed.
+// Prediction looks ahead through the stream, so a parse is stopped even when it is not consuming tokens
+type boundedTokenStream struct {
+	*antlr.CommonTokenStream
+	ctx       context.Context
+	heapStart uint64
+	lookups   int
+}
+
+func newBoundedTokenStream(ctx context.Context, lexer antlr.Lexer) *boundedTokenStream {
+	return &boundedTokenStream{
+		CommonTokenStream: antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel),
+		ctx:               ctx,
+		heapStart:         readHeapSize(),
+	}
+}
+
+func (s *boundedTokenStream) LA(i int) int {
+	s.checkLimits()
+	return s.CommonTokenStream.LA(i)
+}
+
+func (s *boundedTokenStream) LT(k int) antlr.Token {
+	s.checkLimits()
+	return s.CommonTokenStream.LT(k)
+}
+
+func (s *boundedTokenStream) checkLimits() {
+	s.lookups++
+	if s.lookups%parseLimits.checkPeriod != 0 {
+		return
+	}
+	if err := s.ctx.Err(); err != nil {
+		panic(parseAbandoned{reason: fmt.Sprintf("it took longer than %s", parseLimits.timeout), token: s.CommonTokenStream.LT(1)})
+	}
+	if heapSize := readHeapSize(); heapSize > s.heapStart && heapSize-s.heapStart > parseLimits.heapGrowth {
+		panic(parseAbandoned{reason: fmt.Sprintf("it grew the heap by more than %d MB", parseLimits.heapGrowth>>20), token: s.CommonTokenStream.LT(1)})
+	}
+}
+
+// readHeapSize
+// The bytes held by objects on the heap, which unlike runtime.ReadMemStats does not stop the world
+func readHeapSize() uint64 {
+	sample := []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
+
+// bailErrorStrategy
+// The BailErrorStrategy of the Go runtime only sets an error, which the generated rules clear again
+// before carrying on, so it does not stop the parse. This one panics at the first syntax error
+// the way the other runtimes throw, without reporting it
+type bailErrorStrategy struct {
+	*antlr.DefaultErrorStrategy
+}
+
+func (b *bailErrorStrategy) ReportError(antlr.Parser, antlr.RecognitionException) {}
+
+func (b *bailErrorStrategy) Recover(antlr.Parser, antlr.RecognitionException) {
+	panic(parseBailed{})
+}
+
+func (b *bailErrorStrategy) RecoverInline(antlr.Parser) antlr.Token {
+	panic(parseBailed{})
+}
+
+func (b *bailErrorStrategy) Sync(antlr.Parser) {}
+
+// parseProgram
+// Parses with SLL prediction first, which is fast and enough for nearly every file.
+// Only when that finds a syntax error is the file parsed again with full LL prediction,
+// which reports the syntax errors and recovers from them.
+// Both are bound by parseLimits through the token stream, an error is returned when they were passed
+func parseProgram(tsParser *parser.TypeScriptParser, tokenStream *boundedTokenStream) (tree parser.IProgramContext, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			abandoned, ok := recovered.(parseAbandoned)
+			if !ok {
+				panic(recovered)
+			}
+			tree, err = nil, abandoned
+		}
+	}()
+
+	tree, ok := parseProgramWithSLL(tsParser)
+	if !ok {
+		tokenStream.Seek(0)
+		tsParser.SetError(nil)
+		tsParser.SetErrorHandler(antlr.NewDefaultErrorStrategy())
+		tsParser.GetInterpreter().SetPredictionMode(antlr.PredictionModeLL)
+		tsParser.SetTokenStream(tokenStream)
+		tree = tsParser.Program()
+	}
+	rereadExpressionStatements(tsParser, tokenStream, tree)
+	return tree, nil
+}
+
+// parseProgramWithSLL
+// Gives false if the parse bailed out at a syntax error
+func parseProgramWithSLL(tsParser *parser.TypeScriptParser) (tree parser.IProgramContext, ok bool) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			if _, bailed := recovered.(parseBailed); !bailed {
+				panic(recovered)
+			}
+			tree, ok = nil, false
+		}
+	}()
+
+	tsParser.SetErrorHandler(&bailErrorStrategy{DefaultErrorStrategy: antlr.NewDefaultErrorStrategy()})
+	tsParser.GetInterpreter().SetPredictionMode(antlr.PredictionModeSLL)
+	return tsParser.Program(), true
+}
+
+// rereadExpressionStatements
+// The grammar lets a variable statement leave out let, const and var, and gives it before expression statements,
+// so f(x); is read as the variable f followed by the expression (x), and x = 1; as the variable x.
+// Every variable statement without them is read again as an expression statement,
+// which takes its place in the tree when it is made of the same tokens
+func rereadExpressionStatements(tsParser *parser.TypeScriptParser, tokenStream *boundedTokenStream, tree antlr.Tree) {
+	if statement, ok := tree.(*parser.StatementContext); ok {
+		if variables, ok := statement.VariableStatement().(*parser.VariableStatementContext); ok && isBareVariableStatement(variables) {
+			if expression := parseExpressionStatementAt(tsParser, tokenStream, variables); expression != nil {
+				statement.RemoveLastChild()
+				expression.SetParent(statement)
+				statement.AddChild(expression)
+			}
+		}
+	}
+	for _, child := range tree.GetChildren() {
+		rereadExpressionStatements(tsParser, tokenStream, child)
+	}
+}
+
+// isBareVariableStatement
+// Checks if nothing in the statement says it declares a variable
+func isBareVariableStatement(ctx *parser.VariableStatementContext) bool {
+	return ctx.VarModifier() == nil && ctx.AccessibilityModifier() == nil && ctx.ReadOnly() == nil && ctx.Declare() == nil &&
+		ctx.VariableDeclarationList() != nil
+}
+
+// parseExpressionStatementAt
+// Parses an expression statement from the first token of the variable statement, with SLL and then LL prediction.
+// Gives nil when neither parses it or it does not end where the variable statement does
+func parseExpressionStatementAt(tsParser *parser.TypeScriptParser, tokenStream *boundedTokenStream, variables *parser.VariableStatementContext) *parser.ExpressionStatementContext {
+	if variables.GetStart() == nil || variables.GetStop() == nil {
+		return nil
+	}
+	for _, mode := range []int{antlr.PredictionModeSLL, antlr.PredictionModeLL} {
+		expression, ok := parseExpressionStatement(tsParser, tokenStream, variables.GetStart().GetTokenIndex(), mode)
+		if !ok {
+			continue
+		}
+		if expression.GetStop() == nil || expression.GetStop().GetTokenIndex() != variables.GetStop().GetTokenIndex() {
+			return nil
+		}
+		return expression
+	}
+	return nil
+}
+
+// parseExpressionStatement
+// Gives false if the parse bailed out at a syntax error
+func parseExpressionStatement(tsParser *parser.TypeScriptParser, tokenStream *boundedTokenStream, start int, mode int) (expression *parser.ExpressionStatementContext, ok bool) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			if _, bailed := recovered.(parseBailed); !bailed {
+				panic(recovered)
+			}
+			expression, ok = nil, false
+		}
+	}()
+
+	tsParser.SetError(nil)
+	tsParser.SetErrorHandler(&bailErrorStrategy{DefaultErrorStrategy: antlr.NewDefaultErrorStrategy()})
+	tsParser.GetInterpreter().SetPredictionMode(mode)
+	tsParser.SetTokenStream(tokenStream)
+	tokenStream.Seek(start)
+	expression, ok = tsParser.ExpressionStatement().(*parser.ExpressionStatementContext)
+	return expression, ok && !tsParser.HasError()
+}
@@ -0,0 +1,989 @@
+package typescript
+
+import (
+	"SubmissionGrader/internal/common"
+	"SubmissionGrader/internal/complexity/complexCommons"
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+	"SubmissionGrader/internal/complexity/stateInfo"
+	parser "SubmissionGrader/internal/complexity/typescript/typeScriptAntlrParser"
+	"fmt"
+	"sort"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// Kinds of frames pushed whenever a function-like node is entered so that
+// the matching exit knows what needs to be undone
+const (
+	frameIgnored = iota // function without a body, such as an overload signature
+	frameMethod         // function that is reported as its own method
+)
+
+type functionFrame struct {
+	kind        int
+	pushedState bool
+	path        string // how nested functions refer to this one in their location, such as Class.method
+	halstead    *halsteadCounter
+	memberOf    *complexCommons.ClassInfo // class this function is a method of (lambdas inside it share it)
+	memberIndex int
+	ownsMember  bool // the function is the method itself rather than one nested in it
+	isMember    bool // declared directly in a class, so it can only be called through this or the class
+	locals      map[string]bool
+	tokens      []complexCommons.NormalizedToken
+	async       asyncTracker
+}
+
+// typescriptComplexityListener
+// Walks the TypeScriptParser parse tree and keeps track of the methods found
+// along with their cyclomatic and cognitive complexity
+type typescriptComplexityListener struct {
+	*parser.BaseTypeScriptParserListener
+
+	lexer         *parser.TypeScriptLexer
+	includeTokens bool
+
+	stateStack   *stateInfo.StateStack
+	currentState stateInfo.StateInfo
+	finalStack   *methodInfoType.MethodStack
+
+	functionFrames  []functionFrame
+	classFrames     []bool
+	classModels     []*complexCommons.ClassInfo // nil for classes without a name
+	classes         []complexCommons.ClassInfo
+	imports         []complexCommons.ImportInfo
+	unparsedImports []complexCommons.ImportInfo // from rewriteModernSyntax
+	methodTokens    []complexCommons.MethodTokens
+	functions       []complexCommons.FunctionInfo
+	calls           []complexCommons.CallInfo
+	lambdaCounts    map[string]int
+	classSpans      map[string][]lineSpan // a class name can be declared more than once when nested
+	optionalChains  map[int]bool          // from rewriteModernSyntax
+	logicalAssigns  map[int]bool
+	awaits          map[int]bool
+	asyncFunctions  map[int]bool
+	asyncNames      map[string]bool
+	jsxNames        map[string]int
+	declarations    []complexCommons.Declaration
+	unreachable     []complexCommons.UnreachableCode
+}
+
+// lineSpan
+// The first and last line of something in the file
+type lineSpan struct {
+	start int
+	end   int
+}
+
+func newTypescriptComplexityListener(lexer *parser.TypeScriptLexer, includeTokens bool, source rewrittenSource) *typescriptComplexityListener {
+	return &typescriptComplexityListener{
+		BaseTypeScriptParserListener: &parser.BaseTypeScriptParserListener{},
+		lexer:                        lexer,
+		includeTokens:                includeTokens,
+		stateStack:                   stateInfo.NewStateStack(),
+		currentState:                 stateInfo.NewStateObject(),
+		finalStack:                   methodInfoType.NewMethodStack(),
+		lambdaCounts:                 map[string]int{},
+		classSpans:                   map[string][]lineSpan{},
+		optionalChains:               source.optionalChains,
+		logicalAssigns:               source.logicalAssignments,
+		awaits:                       source.awaits,
+		asyncFunctions:               source.asyncFunctions,
+		asyncNames:                   source.asyncNames,
+		jsxNames:                     source.jsxNames,
+		unparsedImports:              source.unparsedImports,
+	}
+}
+
+// GetMethods
+// Returns every method that has been finished while walking the tree
+func (l *typescriptComplexityListener) GetMethods() []methodInfoType.MethodInfo {
+	return l.finalStack.ConvertToArray()
+}
+
+// GetClasses
+// Returns every named class that has been finished while walking the tree
+func (l *typescriptComplexityListener) GetClasses() []complexCommons.ClassInfo {
+	return l.classes
+}
+
+// GetMethodTokens
+// Returns the normalised tokens of every finished method
+func (l *typescriptComplexityListener) GetMethodTokens() []complexCommons.MethodTokens {
+	return l.methodTokens
+}
+
+// GetFunctions
+// Returns every function and method found while walking the tree, in the order they start
+func (l *typescriptComplexityListener) GetFunctions() []complexCommons.FunctionInfo {
+	return l.functions
+}
+
+// GetCalls
+// Returns every call made in a function that complexCommons.BuildCallGraph could resolve
+func (l *typescriptComplexityListener) GetCalls() []complexCommons.CallInfo {
+	return l.calls
+}
+
+// GetDeclarations
+// Returns the functions, methods, classes, imports and variables declared in the file
+func (l *typescriptComplexityListener) GetDeclarations() []complexCommons.Declaration {
+	return l.declarations
+}
+
+// GetUnreachable
+// Returns the first statement of every block that can never run
+func (l *typescriptComplexityListener) GetUnreachable() []complexCommons.UnreachableCode {
+	return l.unreachable
+}
+
+// GetImports
+// Returns every module the file imports, exports from or requires, in the order they appear
+func (l *typescriptComplexityListener) GetImports() []complexCommons.ImportInfo {
+	imports := append(append([]complexCommons.ImportInfo{}, l.imports...), l.unparsedImports...)
+	sort.SliceStable(imports, func(i, j int) bool {
+		return imports[i].Line < imports[j].Line
+	})
+	return imports
+}
+
+// VisitTerminal
+// Counts the token towards the Halstead metrics of the current method, keeps its normalised
+// text for clone detection and records it into the method when tokens were requested
+func (l *typescriptComplexityListener) VisitTerminal(node antlr.TerminalNode) {
+	t := node.GetSymbol()
+	if class := l.getCurrentClassModel(); class != nil && t.GetTokenType() == parser.TypeScriptLexerIdentifier {
+		class.References[t.GetText()] = true
+	}
+	if !l.currentState.InMethod {
+		return
+	}
+	if t.GetTokenType() == antlr.TokenEOF {
+		return
+	}
+	frame := l.getCurrentMethodFrame()
+	if frame != nil {
+		frame.halstead.addToken(node)
+		frame.tokens = append(frame.tokens, complexCommons.NormalizedToken{Text: normalizeToken(t), Original: t.GetText(), Line: t.GetLine()})
+	}
+	if !l.includeTokens {
+		return
+	}
+	l.currentState.CurrentMethodInfo.AddTokenToMethod(t.GetLine(), -1, l.lexer.SymbolicNames[t.GetTokenType()], l.lexer.RuleNames[t.GetTokenType()], t.GetText())
+}
+
+// ---------------------------------------------------------------------------
+// Classes
+// ---------------------------------------------------------------------------
+
+func (l *typescriptComplexityListener) EnterClassDeclaration(ctx *parser.ClassDeclarationContext) {
+	pushed := false
+	if l.currentState.InClass || l.currentState.InMethod {
+		l.pushStateWithNestedLocation()
+		pushed = true
+	}
+	l.classFrames = append(l.classFrames, pushed)
+	l.classModels = append(l.classModels, newClassModel(ctx))
+	if ctx.DecoratorList() == nil { // decorated classes are used by their framework
+		l.addDeclaration(ctx.Identifier(), complexCommons.DeclarationClass, ctx.Export() != nil || isExported(ctx))
+	}
+
+	l.currentState.InClass = true
+	if ctx.Identifier() != nil {
+		l.currentState.ClassName = ctx.Identifier().GetText()
+		span := lineSpan{start: ctx.GetStart().GetLine(), end: ctx.GetStop().GetLine()}
+		l.classSpans[l.currentState.ClassName] = append(l.classSpans[l.currentState.ClassName], span)
+	}
+}
+
+func (l *typescriptComplexityListener) ExitClassDeclaration(ctx *parser.ClassDeclarationContext) {
+	if len(l.classFrames) == 0 {
+		return
+	}
+	pushed := l.classFrames[len(l.classFrames)-1]
+	l.classFrames = l.classFrames[:len(l.classFrames)-1]
+	if class := l.classModels[len(l.classModels)-1]; class != nil {
+		l.classes = append(l.classes, *class)
+	}
+	l.classModels = l.classModels[:len(l.classModels)-1]
+
+	if pushed {
+		if !complexCommons.RestorePreviousState(&l.currentState, l.stateStack) {
+			common.Error(fmt.Sprintf("Failed to restore state after class: %s\n", l.currentState.ClassName))
+		}
+	} else {
+		l.currentState.InClass = false
+		l.currentState.ClassName = ""
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Functions and methods
+// ---------------------------------------------------------------------------
+
+func (l *typescriptComplexityListener) EnterFunctionDeclaration(ctx *parser.FunctionDeclarationContext) {
+	if ctx.FunctionBody() == nil || ctx.Identifier() == nil { // overload signature, there is nothing to measure
+		l.enterIgnoredFunction()
+		return
+	}
+	l.addDeclaration(ctx.Identifier(), complexCommons.DeclarationFunction, isExported(ctx))
+	var params antlr.Tree
+	if ctx.CallSignature() != nil {
+		params = ctx.CallSignature().ParameterList()
+	}
+	l.enterMethod(ctx.Identifier().GetText(), params, ctx)
+}
+
+func (l *typescriptComplexityListener) ExitFunctionDeclaration(ctx *parser.FunctionDeclarationContext) {
+	l.exitFunction(ctx.GetStop().GetLine())
+}
+
+func (l *typescriptComplexityListener) EnterMethodDeclarationExpression(ctx *parser.MethodDeclarationExpressionContext) {
+	if ctx.FunctionBody() == nil || ctx.PropertyName() == nil { // abstract or overload signature
+		l.enterIgnoredFunction()
+		return
+	}
+	if !hasOutsideCallers(ctx) {
+		l.addDeclaration(getPropertyIdentifier(ctx.PropertyName()), complexCommons.DeclarationMethod, false)
+	}
+	var params antlr.Tree
+	if ctx.CallSignature() != nil {
+		params = ctx.CallSignature().ParameterList()
+	}
+	l.enterMethod(ctx.PropertyName().GetText(), params, ctx)
+}
+
+func (l *typescriptComplexityListener) ExitMethodDeclarationExpression(ctx *parser.MethodDeclarationExpressionContext) {
+	l.exitFunction(ctx.GetStop().GetLine())
+}
+
+func (l *typescriptComplexityListener) EnterConstructorDeclaration(ctx *parser.ConstructorDeclarationContext) {
+	if ctx.FunctionBody() == nil {
+		l.enterIgnoredFunction()
+		return
+	}
+	// Parameters with an accessibility modifier (constructor(private name: string)) are fields too
+	if class := l.getCurrentClassModel(); class != nil {
+		for _, parameter := range GetParameters(ctx.FormalParameterList()) {
+			if parameter.Accessibility != "" {
+				class.Fields[parameter.Name] = true
+			}
+		}
+	}
+	l.enterMethod("constructor", ctx.FormalParameterList(), ctx)
+}
+
+// EnterPropertyDeclarationExpression
+// Properties declared in the class body are its fields
+func (l *typescriptComplexityListener) EnterPropertyDeclarationExpression(ctx *parser.PropertyDeclarationExpressionContext) {
+	class := l.getCurrentClassModel()
+	if class == nil || l.currentState.InMethod || ctx.PropertyName() == nil {
+		return
+	}
+	class.Fields[ctx.PropertyName().GetText()] = true
+}
+
+func (l *typescriptComplexityListener) ExitConstructorDeclaration(ctx *parser.ConstructorDeclarationContext) {
+	l.exitFunction(ctx.GetStop().GetLine())
+}
+
+func (l *typescriptComplexityListener) EnterGetAccessor(ctx *parser.GetAccessorContext) {
+	name := "get"
+	if ctx.Getter() != nil && ctx.Getter().PropertyName() != nil {
+		name += " " + ctx.Getter().PropertyName().GetText()
+	}
+	l.enterMethod(name, nil, ctx)
+}
+
+func (l *typescriptComplexityListener) ExitGetAccessor(ctx *parser.GetAccessorContext) {
+	l.exitFunction(ctx.GetStop().GetLine())
+}
+
+func (l *typescriptComplexityListener) EnterSetAccessor(ctx *parser.SetAccessorContext) {
+	name := "set"
+	if ctx.Setter() != nil && ctx.Setter().PropertyName() != nil {
+		name += " " + ctx.Setter().PropertyName().GetText()
+	}
+	var params antlr.Tree
+	if ctx.Identifier() != nil {
+		params = ctx.Identifier()
+	} else if ctx.BindingPattern() != nil {
+		params = ctx.BindingPattern()
+	}
+	l.enterMethod(name, params, ctx)
+}
+
+func (l *typescriptComplexityListener) ExitSetAccessor(ctx *parser.SetAccessorContext) {
+	l.exitFunction(ctx.GetStop().GetLine())
+}
+
+func (l *typescriptComplexityListener) EnterGeneratorMethod(ctx *parser.GeneratorMethodContext) {
+	if ctx.Identifier() == nil {
+		l.enterIgnoredFunction()
+		return
+	}
+	l.enterMethod(ctx.Identifier().GetText(), ctx.FormalParameterList(), ctx)
+}
+
+func (l *typescriptComplexityListener) ExitGeneratorMethod(ctx *parser.GeneratorMethodContext) {
+	l.exitFunction(ctx.GetStop().GetLine())
+}
+
+func (l *typescriptComplexityListener) EnterGeneratorFunctionDeclaration(ctx *parser.GeneratorFunctionDeclarationContext) {
+	_, isStatement := ctx.GetParent().(*parser.StatementContext)
+	if !isStatement || ctx.Identifier() == nil { // generator used as an expression
+		l.enterLambda(ctx, ctx.FormalParameterList())
+		return
+	}
+	l.addDeclaration(ctx.Identifier(), complexCommons.DeclarationFunction, isExported(ctx))
+	l.enterMethod(ctx.Identifier().GetText(), ctx.FormalParameterList(), ctx)
+}
+
+func (l *typescriptComplexityListener) ExitGeneratorFunctionDeclaration(ctx *parser.GeneratorFunctionDeclarationContext) {
+	l.exitFunction(ctx.GetStop().GetLine())
+}
+
+func (l *typescriptComplexityListener) EnterArrowFunctionDeclaration(ctx *parser.ArrowFunctionDeclarationContext) {
+	var params antlr.Tree
+	if arrowParams := ctx.ArrowFunctionParameters(); arrowParams != nil {
+		if arrowParams.FormalParameterList() != nil {
+			params = arrowParams.FormalParameterList()
+		} else if arrowParams.Identifier() != nil {
+			params = arrowParams.Identifier()
+		}
+	}
+	l.enterLambda(ctx, params)
+}
+
+func (l *typescriptComplexityListener) ExitArrowFunctionDeclaration(ctx *parser.ArrowFunctionDeclarationContext) {
+	l.exitFunction(ctx.GetStop().GetLine())
+}
+
+func (l *typescriptComplexityListener) EnterFunctionExpressionDeclaration(ctx *parser.FunctionExpressionDeclarationContext) {
+	l.enterLambda(ctx, ctx.FormalParameterList())
+}
+
+func (l *typescriptComplexityListener) ExitFunctionExpressionDeclaration(ctx *parser.FunctionExpressionDeclarationContext) {
+	l.exitFunction(ctx.GetStop().GetLine())
+}
+
+// ---------------------------------------------------------------------------
+// Statements that add to the complexity (NESTING MATTERS)
+// ---------------------------------------------------------------------------
+
+func (l *typescriptComplexityListener) EnterIfStatement(ctx *parser.IfStatementContext) {
+	if !l.currentState.InMethod {
+		return
+	}
+	l.currentState.IncCycCount(1)
+	if isElseIf(ctx) { // nesting is shared with the parent if, so the else if only costs one
+		l.currentState.IncCogCount(1)
+	} else {
+		l.addWithNesting(1)
+		l.increaseNesting()
+	}
+
+	if ctx.Else() != nil && !hasElseIf(ctx) {
+		l.currentState.IncCogCount(1)
+	}
+}
+
+func (l *typescriptComplexityListener) ExitIfStatement(ctx *parser.IfStatementContext) {
+	if !l.currentState.InMethod || isElseIf(ctx) {
+		return
+	}
+	l.currentState.NestingCount--
+}
+
+func (l *typescriptComplexityListener) EnterDoStatement(ctx *parser.DoStatementContext) {
+	l.enterLoop()
+}
+
+func (l *typescriptComplexityListener) ExitDoStatement(ctx *parser.DoStatementContext) {
+	l.exitLoop()
+}
+
+func (l *typescriptComplexityListener) EnterWhileStatement(ctx *parser.WhileStatementContext) {
+	l.enterLoop()
+}
+
+func (l *typescriptComplexityListener) ExitWhileStatement(ctx *parser.WhileStatementContext) {
+	l.exitLoop()
+}
+
+func (l *typescriptComplexityListener) EnterForStatement(ctx *parser.ForStatementContext) {
+	l.enterLoop()
+}
+
+func (l *typescriptComplexityListener) ExitForStatement(ctx *parser.ForStatementContext) {
+	l.exitLoop()
+}
+
+func (l *typescriptComplexityListener) EnterForVarStatement(ctx *parser.ForVarStatementContext) {
+	l.enterLoop()
+}
+
+func (l *typescriptComplexityListener) ExitForVarStatement(ctx *parser.ForVarStatementContext) {
+	l.exitLoop()
+}
+
+func (l *typescriptComplexityListener) EnterForInStatement(ctx *parser.ForInStatementContext) {
+	l.enterLoop()
+}
+
+func (l *typescriptComplexityListener) ExitForInStatement(ctx *parser.ForInStatementContext) {
+	l.exitLoop()
+}
+
+func (l *typescriptComplexityListener) EnterForVarInStatement(ctx *parser.ForVarInStatementContext) {
+	l.enterLoop()
+}
+
+func (l *typescriptComplexityListener) ExitForVarInStatement(ctx *parser.ForVarInStatementContext) {
+	l.exitLoop()
+}
+
+func (l *typescriptComplexityListener) EnterSwitchStatement(ctx *parser.SwitchStatementContext) {
+	l.enterScopedItem(0) // each case is what adds to the cyclomatic count
+}
+
+func (l *typescriptComplexityListener) ExitSwitchStatement(ctx *parser.SwitchStatementContext) {
+	l.exitScopedItem()
+}
+
+func (l *typescriptComplexityListener) EnterCatchProduction(ctx *parser.CatchProductionContext) {
+	l.enterScopedItem(1)
+}
+
+func (l *typescriptComplexityListener) ExitCatchProduction(ctx *parser.CatchProductionContext) {
+	l.exitScopedItem()
+}
+
+func (l *typescriptComplexityListener) EnterTernaryExpression(ctx *parser.TernaryExpressionContext) {
+	l.enterScopedItem(1)
+}
+
+func (l *typescriptComplexityListener) ExitTernaryExpression(ctx *parser.TernaryExpressionContext) {
+	l.exitScopedItem()
+}
+
+// ---------------------------------------------------------------------------
+// Items that only add to the complexity
+// ---------------------------------------------------------------------------
+
+func (l *typescriptComplexityListener) EnterCaseClause(ctx *parser.CaseClauseContext) {
+	if l.currentState.InMethod {
+		l.currentState.IncCycCount(1)
+	}
+}
+
+func (l *typescriptComplexityListener) EnterDefaultClause(ctx *parser.DefaultClauseContext) {
+	if l.currentState.InMethod {
+		l.currentState.IncCycCount(1)
+	}
+}
+
+func (l *typescriptComplexityListener) EnterThrowStatement(ctx *parser.ThrowStatementContext) {
+	if l.currentState.InMethod {
+		l.currentState.IncCycCount(1)
+	}
+}
+
+func (l *typescriptComplexityListener) EnterBreakStatement(ctx *parser.BreakStatementContext) {
+	if l.currentState.InMethod && ctx.Identifier() != nil { // break to a label
+		l.currentState.IncCogCount(1)
+	}
+}
+
+func (l *typescriptComplexityListener) EnterContinueStatement(ctx *parser.ContinueStatementContext) {
+	if l.currentState.InMethod && ctx.Identifier() != nil { // continue to a label
+		l.currentState.IncCogCount(1)
+	}
+}
+
+func (l *typescriptComplexityListener) EnterLogicalAndExpression(ctx *parser.LogicalAndExpressionContext) {
+	if !l.currentState.InMethod {
+		return
+	}
+	l.currentState.IncCycCount(1)
+	if _, sameSequence := ctx.GetParent().(*parser.LogicalAndExpressionContext); !sameSequence {
+		l.currentState.IncCogCount(1)
+	}
+}
+
+func (l *typescriptComplexityListener) EnterLogicalOrExpression(ctx *parser.LogicalOrExpressionContext) {
+	if !l.currentState.InMethod {
+		return
+	}
+	l.currentState.IncCycCount(1)
+	if _, sameSequence := ctx.GetParent().(*parser.LogicalOrExpressionContext); !sameSequence {
+		l.currentState.IncCogCount(1)
+	}
+}
+
+// EnterAssignmentOperatorExpression
+// a ??= b, a ||= b and a &&= b only assign depending on a, so each is counted like a ?? or ||
+func (l *typescriptComplexityListener) EnterAssignmentOperatorExpression(ctx *parser.AssignmentOperatorExpressionContext) {
+	if l.currentState.InMethod && ctx.AssignmentOperator() != nil && l.logicalAssigns[ctx.AssignmentOperator().GetStart().GetStart()] {
+		l.currentState.IncCycCount(1)
+		l.currentState.IncCogCount(1)
+	}
+}
+
+// Optional chains (a?.b, a?.[i], a?.(x)) are counted the same way as && and ||,
+// where a chain with several ?. only adds once to the cognitive complexity
+
+func (l *typescriptComplexityListener) EnterMemberDotExpression(ctx *parser.MemberDotExpressionContext) {
+	if !l.currentState.InMethod {
+		return
+	}
+	if l.isOptionalChain(ctx) {
+		l.addOptionalChain(ctx.SingleExpression())
+	}
+	// this.name is how a method uses the fields of its class
+	if ctx.SingleExpression() != nil && ctx.SingleExpression().GetText() == "this" && ctx.IdentifierName() != nil {
+		if frame := l.getCurrentMethodFrame(); frame != nil && frame.memberOf != nil {
+			frame.memberOf.Methods[frame.memberIndex].FieldsUsed[ctx.IdentifierName().GetText()] = true
+		}
+	}
+}
+
+func (l *typescriptComplexityListener) EnterMemberIndexExpression(ctx *parser.MemberIndexExpressionContext) {
+	if l.currentState.InMethod && l.isOptionalChain(ctx) {
+		l.addOptionalChain(ctx.SingleExpression())
+	}
+}
+
+func (l *typescriptComplexityListener) EnterArgumentsExpression(ctx *parser.ArgumentsExpressionContext) {
+	if module, ok := getRequiredModule(ctx); ok {
+		l.addImport(module, complexCommons.ImportKindRequire, ctx.GetStart().GetLine())
+	}
+	if !l.currentState.InMethod {
+		return
+	}
+	if l.isOptionalChain(ctx) {
+		l.addOptionalChain(ctx.SingleExpression())
+	}
+	if getPromiseLink(ctx) != "" {
+		l.addPromiseLink(ctx)
+	}
+	if l.isRecursiveCall(ctx) {
+		l.currentState.IncCogCount(1)
+	}
+	l.addCall(ctx)
+}
+
+// EnterVariableDeclaration
+// Variables hide functions of the same name declared further out
+func (l *typescriptComplexityListener) EnterVariableDeclaration(ctx *parser.VariableDeclarationContext) {
+	if ctx.IdentifierOrKeyWord() == nil { // destructuring
+		return
+	}
+	if name, ok := ctx.IdentifierOrKeyWord().GetChild(0).(antlr.TerminalNode); ok {
+		l.addDeclaration(name, complexCommons.DeclarationVariable, isExported(ctx))
+	}
+	if !l.currentState.InMethod {
+		return
+	}
+	if frame := l.getCurrentMethodFrame(); frame != nil {
+		frame.locals[ctx.IdentifierOrKeyWord().GetText()] = true
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Imports
+// ---------------------------------------------------------------------------
+
+func (l *typescriptComplexityListener) EnterImportStatement(ctx *parser.ImportStatementContext) {
+	l.addImportDeclarations(ctx)
+	if module, ok := getFromBlockModule(ctx.FromBlock()); ok {
+		l.addImport(module, complexCommons.ImportKindImport, ctx.GetStart().GetLine())
+	}
+}
+
+func (l *typescriptComplexityListener) EnterExportStatement(ctx *parser.ExportStatementContext) {
+	if module, ok := getFromBlockModule(ctx.FromBlock()); ok {
+		l.addImport(module, complexCommons.ImportKindExport, ctx.GetStart().GetLine())
+	}
+}
+
+func (l *typescriptComplexityListener) addImport(module string, kind string, line int) {
+	l.imports = append(l.imports, complexCommons.ImportInfo{Module: module, Kind: kind, Line: line})
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+// enterMethod
+// Creates a new method info object and makes it the current method.
+// If we are already inside a method, the state is saved first so the
+// nested function is reported on its own
+func (l *typescriptComplexityListener) enterMethod(methodName string, params antlr.Tree, ctx antlr.ParserRuleContext) {
+	memberOf, memberIndex, ownsMember := l.getClassMember(methodName)
+	async := l.newAsyncTracker(ctx)
+	isMember := l.isDeclaringMember()
+	scope := l.getEnclosingPath()
+	if parent := l.getCurrentMethodFrame(); parent != nil && l.currentState.InMethod {
+		parent.locals[methodName] = true
+	}
+	pushed := false
+	path := methodName
+	if l.currentState.InMethod {
+		l.pushStateWithLocation(l.getEnclosingPath(), l.currentState.ClassName)
+		pushed = true
+	} else if l.currentState.ClassName != "" {
+		path = l.currentState.ClassName + "." + methodName
+	}
+	path = joinLocation(l.currentState.Location, path)
+
+	l.startMethod(methodName, params, ctx.GetStart().GetLine())
+	locals := l.addFunction(path, methodName, scope, isMember, params)
+	l.functionFrames = append(l.functionFrames, functionFrame{kind: frameMethod, pushedState: pushed, path: path, halstead: newHalsteadCounter(), memberOf: memberOf, memberIndex: memberIndex, ownsMember: ownsMember, isMember: isMember, locals: locals, async: async})
+}
+
+// enterLambda
+// Arrow functions, function expressions and anonymous generators are reported
+// as their own methods. They get a name from whatever they are bound to and a
+// location such as Class.method->lambda#2. A callback nested in other callbacks
+// adds how deep it is to its cognitive complexity
+func (l *typescriptComplexityListener) enterLambda(ctx antlr.ParserRuleContext, params antlr.Tree) {
+	name := GetFunctionName(ctx)
+	memberOf, memberIndex, ownsMember := l.getClassMember(name)
+	async := l.newAsyncTracker(ctx)
+	isMember := l.isDeclaringMember()
+	parentPath := l.getEnclosingPath()
+	l.lambdaCounts[parentPath]++
+	location := joinLocation(parentPath, fmt.Sprintf("lambda#%d", l.lambdaCounts[parentPath]))
+
+	l.pushStateWithLocation(location, l.currentState.ClassName)
+	l.startMethod(name, params, ctx.GetStart().GetLine())
+	if async.callbackDepth > 1 {
+		l.currentState.IncCogCount(async.callbackDepth - 1)
+	}
+	locals := l.addFunction(location, name, parentPath, isMember, params)
+	l.functionFrames = append(l.functionFrames, functionFrame{kind: frameMethod, pushedState: true, path: location, halstead: newHalsteadCounter(), memberOf: memberOf, memberIndex: memberIndex, ownsMember: ownsMember, isMember: isMember, locals: locals, async: async})
+}
+
+// enterIgnoredFunction
+// Functions without a body have nothing to measure, but still need a frame so the exit lines up
+func (l *typescriptComplexityListener) enterIgnoredFunction() {
+	l.functionFrames = append(l.functionFrames, functionFrame{kind: frameIgnored})
+}
+
+func (l *typescriptComplexityListener) startMethod(methodName string, params antlr.Tree, startLine int) {
+	newMethod := methodInfoType.MethodInfo{
+		Location:           "",
+		Class:              l.currentState.ClassName,
+		MethodName:         methodName,
+		Parameter:          GetParameterText(params),
+		Parameters:         GetParameters(params),
+		StartLine:          startLine,
+		EndLine:            -1,
+		TotalLine:          -1,
+		LinesOfCodeCreated: -1,
+		CogCount:           1,
+		CycCount:           1,
+	}
+	l.currentState.CurrentMethodInfo = &newMethod
+	l.currentState.InMethod = true
+	l.currentState.NestingCount = 0
+}
+
+// exitFunction
+// Undoes whatever the matching enter did, finishing the method if one was created
+func (l *typescriptComplexityListener) exitFunction(endLine int) {
+	if len(l.functionFrames) == 0 {
+		return
+	}
+	frame := l.functionFrames[len(l.functionFrames)-1]
+	l.functionFrames = l.functionFrames[:len(l.functionFrames)-1]
+
+	if frame.kind != frameMethod {
+		return
+	}
+	methodName := l.currentState.CurrentMethodInfo.MethodName
+	l.currentState.CurrentMethodInfo.Halstead = frame.halstead.metrics()
+	l.currentState.CurrentMethodInfo.Async = frame.async.info
+	if parent := l.getCurrentMethodFrame(); parent != nil && frame.async.info.MaxCallbackNesting > parent.async.info.MaxCallbackNesting {
+		parent.async.info.MaxCallbackNesting = frame.async.info.MaxCallbackNesting
+	}
+	if frame.ownsMember {
+		frame.memberOf.Methods[frame.memberIndex].CycCount = l.currentState.CurrentMethodInfo.CycCount
+	}
+	l.methodTokens = append(l.methodTokens, complexCommons.MethodTokens{
+		Location:   l.currentState.CurrentMethodInfo.Location,
+		Class:      l.currentState.CurrentMethodInfo.Class,
+		MethodName: methodName,
+		StartLine:  l.currentState.CurrentMethodInfo.StartLine,
+		EndLine:    endLine,
+		Tokens:     frame.tokens,
+	})
+	if !complexCommons.FinishMethod(&l.currentState, l.finalStack, endLine) {
+		common.Error(fmt.Sprintf("Failed to finish method: %s\n", methodName))
+	}
+	if frame.pushedState && !complexCommons.RestorePreviousState(&l.currentState, l.stateStack) {
+		common.Error(fmt.Sprintf("Failed to restore state after method: %s\n", methodName))
+	}
+}
+
+// addFunction
+// Records the function for the call graph, returning its locals which start with its parameters
+func (l *typescriptComplexityListener) addFunction(key string, name string, scope string, isMember bool, params antlr.Tree) map[string]bool {
+	locals := map[string]bool{}
+	for _, parameter := range GetParameters(params) {
+		locals[parameter.Name] = true
+	}
+	l.functions = append(l.functions, complexCommons.FunctionInfo{
+		Key:       key,
+		Name:      name,
+		Class:     l.currentState.ClassName,
+		Scope:     scope,
+		Member:    isMember,
+		StartLine: l.currentState.CurrentMethodInfo.StartLine,
+		Locals:    locals,
+	})
+	return locals
+}
+
+// isDeclaringMember
+// Checks if a function entered now is declared directly in the body of a class
+func (l *typescriptComplexityListener) isDeclaringMember() bool {
+	return l.currentState.InClass && !l.currentState.InMethod
+}
+
+// isRecursiveCall
+// A method calls itself through this.name, and any other function through its name,
+// unless one of its parameters, variables or nested functions has the same name
+func (l *typescriptComplexityListener) isRecursiveCall(ctx *parser.ArgumentsExpressionContext) bool {
+	frame := l.getCurrentMethodFrame()
+	if frame == nil {
+		return false
+	}
+	name := l.currentState.CurrentMethodInfo.MethodName
+	switch callee := ctx.SingleExpression().(type) {
+	case *parser.IdentifierExpressionContext:
+		return !frame.isMember && callee.IdentifierName() != nil && callee.IdentifierName().GetText() == name && !frame.locals[name]
+	case *parser.MemberDotExpressionContext:
+		return frame.isMember && callee.IdentifierName() != nil && callee.IdentifierName().GetText() == name &&
+			callee.SingleExpression() != nil && callee.SingleExpression().GetText() == complexCommons.CallReceiverThis
+	}
+	return false
+}
+
+// addCall
+// Records the call for the call graph. Calls on anything but this, super or a plain
+// name, such as items.push(x), cannot be resolved to a function and are left out
+func (l *typescriptComplexityListener) addCall(ctx *parser.ArgumentsExpressionContext) {
+	frame := l.getCurrentMethodFrame()
+	if frame == nil {
+		return
+	}
+	call := complexCommons.CallInfo{Caller: frame.path, Class: l.currentState.ClassName, Line: ctx.GetStart().GetLine()}
+	switch callee := ctx.SingleExpression().(type) {
+	case *parser.IdentifierExpressionContext:
+		if callee.IdentifierName() == nil {
+			return
+		}
+		call.Name = callee.IdentifierName().GetText()
+	case *parser.SuperExpressionContext: // super(...) in a constructor
+		call.Receiver = complexCommons.CallReceiverSuper
+		call.Name = "constructor"
+	case *parser.MemberDotExpressionContext:
+		if callee.IdentifierName() == nil {
+			return
+		}
+		call.Name = callee.IdentifierName().GetText()
+		switch receiver := callee.SingleExpression().(type) {
+		case *parser.IdentifierExpressionContext:
+			call.Receiver = receiver.GetText()
+		case *parser.ThisExpressionContext:
+			call.Receiver = complexCommons.CallReceiverThis
+		case *parser.SuperExpressionContext:
+			call.Receiver = complexCommons.CallReceiverSuper
+		default:
+			return
+		}
+	default:
+		return
+	}
+	l.calls = append(l.calls, call)
+}
+
+// getClassMember
+// A function declared directly in the body of a class (including arrow functions assigned to
+// properties) becomes a new method of that class, while functions nested in a method belong to that method
+func (l *typescriptComplexityListener) getClassMember(name string) (*complexCommons.ClassInfo, int, bool) {
+	if l.currentState.InMethod {
+		if frame := l.getCurrentMethodFrame(); frame != nil {
+			return frame.memberOf, frame.memberIndex, false
+		}
+		return nil, 0, false
+	}
+	class := l.getCurrentClassModel()
+	if class == nil || !l.currentState.InClass {
+		return nil, 0, false
+	}
+	class.Methods = append(class.Methods, complexCommons.ClassMethodInfo{Name: name, FieldsUsed: map[string]bool{}})
+	return class, len(class.Methods) - 1, true
+}
+
+func (l *typescriptComplexityListener) getCurrentClassModel() *complexCommons.ClassInfo {
+	if len(l.classModels) == 0 {
+		return nil
+	}
+	return l.classModels[len(l.classModels)-1]
+}
+
+// newClassModel
+// Starts the model of a class with what its heritage says about it
+func newClassModel(ctx *parser.ClassDeclarationContext) *complexCommons.ClassInfo {
+	if ctx.Identifier() == nil {
+		return nil
+	}
+	class := &complexCommons.ClassInfo{
+		Name:       ctx.Identifier().GetText(),
+		Fields:     map[string]bool{},
+		References: map[string]bool{},
+	}
+	heritage, ok := ctx.ClassHeritage().(*parser.ClassHeritageContext)
+	if !ok {
+		return class
+	}
+	if extends, ok := heritage.ClassExtendsClause().(*parser.ClassExtendsClauseContext); ok {
+		class.Extends = getTypeReferenceName(extends.TypeReference())
+	}
+	if implements, ok := heritage.ImplementsClause().(*parser.ImplementsClauseContext); ok {
+		if typeList, ok := implements.ClassOrInterfaceTypeList().(*parser.ClassOrInterfaceTypeListContext); ok {
+			for _, typeReference := range typeList.AllTypeReference() {
+				class.Implements = append(class.Implements, getTypeReferenceName(typeReference))
+			}
+		}
+	}
+	return class
+}
+
+// getCurrentMethodFrame
+// Gets the frame of the innermost method, skipping frames of functions without a body
+func (l *typescriptComplexityListener) getCurrentMethodFrame() *functionFrame {
+	for i := len(l.functionFrames) - 1; i >= 0; i-- {
+		if l.functionFrames[i].kind == frameMethod {
+			return &l.functionFrames[i]
+		}
+	}
+	return nil
+}
+
+// getEnclosingPath
+// Gets the path of the method we are currently in, or of the class if we are not in a method
+func (l *typescriptComplexityListener) getEnclosingPath() string {
+	if l.currentState.InMethod {
+		if frame := l.getCurrentMethodFrame(); frame != nil {
+			return frame.path
+		}
+	}
+	if l.currentState.InClass {
+		return joinLocation(l.currentState.Location, l.currentState.ClassName)
+	}
+	return l.currentState.Location
+}
+
+// enterScopedItem
+// Adds one to the cognitive complexity (plus the nesting) and cycCount to the
+// cyclomatic complexity, then increases the nesting for everything inside it
+func (l *typescriptComplexityListener) enterScopedItem(cycCount int) {
+	if !l.currentState.InMethod {
+		return
+	}
+	l.currentState.IncCycCount(cycCount)
+	l.addWithNesting(1)
+	l.increaseNesting()
+}
+
+func (l *typescriptComplexityListener) exitScopedItem() {
+	if !l.currentState.InMethod {
+		return
+	}
+	l.currentState.NestingCount--
+}
+
+// isOptionalChain
+// Checks if the member access or call was written with ?. in the original source
+func (l *typescriptComplexityListener) isOptionalChain(tree antlr.Tree) bool {
+	switch expression := tree.(type) {
+	case *parser.MemberDotExpressionContext:
+		return expression.Dot() != nil && l.optionalChains[expression.Dot().GetSymbol().GetStart()]
+	case *parser.MemberIndexExpressionContext:
+		return expression.OpenBracket() != nil && l.optionalChains[expression.OpenBracket().GetSymbol().GetStart()]
+	case *parser.ArgumentsExpressionContext:
+		return expression.Arguments() != nil && l.optionalChains[expression.Arguments().GetStart().GetStart()]
+	}
+	return false
+}
+
+// addOptionalChain
+// Adds one to the cyclomatic complexity, and to the cognitive complexity
+// if nothing before it in the same chain was optional
+func (l *typescriptComplexityListener) addOptionalChain(chain antlr.Tree) {
+	l.currentState.IncCycCount(1)
+	for chain != nil {
+		if l.isOptionalChain(chain) {
+			return
+		}
+		switch expression := chain.(type) {
+		case *parser.MemberDotExpressionContext:
+			chain = expression.SingleExpression()
+		case *parser.MemberIndexExpressionContext:
+			chain = expression.SingleExpression()
+		case *parser.ArgumentsExpressionContext:
+			chain = expression.SingleExpression()
+		default:
+			chain = nil
+		}
+	}
+	l.currentState.IncCogCount(1)
+}
+
+// increaseNesting
+// Goes one level deeper, keeping track of the deepest the method has gone
+func (l *typescriptComplexityListener) increaseNesting() {
+	l.currentState.NestingCount++
+	if l.currentState.NestingCount > l.currentState.CurrentMethodInfo.MaxNesting {
+		l.currentState.CurrentMethodInfo.MaxNesting = l.currentState.NestingCount
+	}
+}
+
+func (l *typescriptComplexityListener) addWithNesting(cogCount int) {
+	l.currentState.IncCogCount(cogCount + l.currentState.NestingCount)
+}
+
+// pushStateWithNestedLocation
+// Saves the current state and starts a fresh one whose location points back to where we came from
+func (l *typescriptComplexityListener) pushStateWithNestedLocation() {
+	newLocation := l.currentState.Location
+	if l.currentState.InClass {
+		newLocation += "->" + l.currentState.ClassName
+		if l.currentState.InMethod {
+			newLocation += "." + l.currentState.CurrentMethodInfo.MethodName
+		}
+	} else if l.currentState.InMethod {
+		newLocation += "->" + l.currentState.CurrentMethodInfo.MethodName
+	}
+	l.stateStack.Push(l.currentState)
+	l.currentState = stateInfo.NewStateObjectWithLocation(newLocation)
+}
+
+// pushStateWithLocation
+// Saves the current state and starts a fresh one for a nested function that
+// keeps the class name of where it was declared
+func (l *typescriptComplexityListener) pushStateWithLocation(location string, className string) {
+	l.stateStack.Push(l.currentState)
+	l.currentState = stateInfo.NewStateObjectWithLocation(location)
+	l.currentState.ClassName = className
+}
+
+func joinLocation(location string, name string) string {
+	if location == "" {
+		return name
+	}
+	return location + "->" + name
+}
@@ -0,0 +1,648 @@
+package typescript
+
+import (
+	"SubmissionGrader/internal/complexity/complexCommons"
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// parseSource
+// Writes the source to a file of the given name and parses it, failing the test on syntax errors
+func parseSource(t *testing.T, fileName string, source string) complexCommons.FileParseResult {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), fileName)
+	if err := os.WriteFile(path, []byte(source), 0666); err != nil {
+		t.Fatal(err)
+	}
+	result := typescriptComplexityParser{}.ParseFile(path, false)
+	if result.Status != complexCommons.ParseStatusParsed {
+		t.Fatalf("parsing %s gave %s: %v", fileName, result.Status, result.Diagnostics)
+	}
+	return result
+}
+
+func findMethod(t *testing.T, methods []methodInfoType.MethodInfo, name string) methodInfoType.MethodInfo {
+	t.Helper()
+	for _, method := range methods {
+		if method.MethodName == name {
+			return method
+		}
+	}
+	t.Fatalf("method %s not found in %v", name, methods)
+	return methodInfoType.MethodInfo{}
+}
+
+func TestComplexityOfStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		cyc    int
+		cog    int
+	}{
+		{
+			name:   "straight line",
+			source: "function f(a: number) {\n  const b = a + 1;\n  return b;\n}\n",
+			cyc:    1,
+			cog:    1,
+		},
+		{
+			name: "if else if else",
+			source: `function f(a: number) {
+  if (a > 0) {
+    return 1;
+  } else if (a < 0) {
+    return -1;
+  } else {
+    return 0;
+  }
+}
+`,
+			cyc: 3,
+			cog: 4, // the else if shares the nesting of its if and the final else adds one
+		},
+		{
+			name: "nested loops",
+			source: `function f(items: number[][]) {
+  let total = 0;
+  for (const row of items) {
+    for (let i = 0; i < row.length; i++) {
+      if (row[i] > 0 && row[i] < 10) {
+        total += row[i];
+      }
+    }
+  }
+  return total;
+}
+`,
+			cyc: 5,
+			cog: 8, // 1 + for (1) + for (1 + 1 nesting) + if (1 + 2 nesting) + && (1)
+		},
+		{
+			name: "while and do while",
+			source: `function f(n: number) {
+  while (n > 10) {
+    n--;
+  }
+  do {
+    n++;
+  } while (n < 5);
+  return n;
+}
+`,
+			cyc: 3,
+			cog: 3,
+		},
+		{
+			name: "switch try catch and ternary",
+			source: `function f(x: string) {
+  switch (x) {
+    case 'a':
+      return 1;
+    case 'b':
+      return 2;
+    default:
+      try {
+        return x.length > 3 ? 3 : 4;
+      } catch (e) {
+        throw e;
+      }
+  }
+}
+`,
+			cyc: 7, // cases, default, catch, ternary and throw each add one
+			cog: 6, // switch (1) + catch (1 + 1 nesting) + ternary (1 + 1 nesting)
+		},
+		{
+			name:   "sequences of logical operators",
+			source: "function f(a: boolean, b: boolean, c: boolean, d: boolean) {\n  return a || b || c && d;\n}\n",
+			cyc:    4,
+			cog:    3, // one for the || sequence and one for the && sequence
+		},
+		{
+			name: "recursive call of a name",
+			source: `function f(n: number): number {
+  if (n <= 1) {
+    return 1;
+  }
+  return n * f(n - 1) + [n][0];
+}
+`,
+			cyc: 2,
+			cog: 3, // 1 + if (1) + calling itself (1)
+		},
+		{
+			name:   "optional chains and nullish coalescing",
+			source: "function f(a: any) {\n  const pattern = /a?.b/;\n  return a?.b?.c ?? a?.[0] ?? a?.(1);\n}\n",
+			cyc:    7, // each ?? and each ?. adds one, the ?. in the regular expression does not
+			cog:    5, // one for the ?? sequence and one for each chain, however many ?. it has
+		},
+		{
+			name: "labelled break",
+			source: `function f(grid: number[][]) {
+  outer: for (const row of grid) {
+    for (const cell of row) {
+      if (cell === 0) {
+        break outer;
+      }
+    }
+  }
+}
+`,
+			cyc: 4,
+			cog: 8, // for (1) + for (1 + 1) + if (1 + 2) + break to a label (1)
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method := findMethod(t, parseSource(t, "test.ts", test.source).Methods, "f")
+			if method.CycCount != test.cyc {
+				t.Errorf("cyclomatic count = %d, want %d", method.CycCount, test.cyc)
+			}
+			if method.CogCount != test.cog {
+				t.Errorf("cognitive count = %d, want %d", method.CogCount, test.cog)
+			}
+		})
+	}
+}
+
+func TestMethodsOfClasses(t *testing.T) {
+	source := `export class Stack {
+  private items: number[] = [];
+
+  constructor(first: number) {
+    this.items.push(first);
+  }
+
+  push(item: number): void {
+    if (item < 0) {
+      throw new Error('negative');
+    }
+    this.items.push(item);
+  }
+
+  get size(): number {
+    return this.items.length;
+  }
+}
+
+function outside() {
+  return new Stack(1);
+}
+`
+	tests := []struct {
+		name      string
+		class     string
+		startLine int
+		endLine   int
+		cyc       int
+	}{
+		{name: "constructor", class: "Stack", startLine: 4, endLine: 6, cyc: 1},
+		{name: "push", class: "Stack", startLine: 8, endLine: 13, cyc: 3},
+		{name: "get size", class: "Stack", startLine: 15, endLine: 17, cyc: 1},
+		{name: "outside", class: "", startLine: 20, endLine: 22, cyc: 1},
+	}
+
+	methods := parseSource(t, "stack.ts", source).Methods
+	if len(methods) != len(tests) {
+		t.Fatalf("found %d methods, want %d: %v", len(methods), len(tests), methods)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method := findMethod(t, methods, test.name)
+			if method.Class != test.class {
+				t.Errorf("class = %q, want %q", method.Class, test.class)
+			}
+			if method.StartLine != test.startLine || method.EndLine != test.endLine {
+				t.Errorf("lines = %d-%d, want %d-%d", method.StartLine, method.EndLine, test.startLine, test.endLine)
+			}
+			if method.CycCount != test.cyc {
+				t.Errorf("cyclomatic count = %d, want %d", method.CycCount, test.cyc)
+			}
+		})
+	}
+}
+
+func TestFunctionsAsMethods(t *testing.T) {
+	source := `const double = (x: number) => x * 2;
+
+const load = async (id: number) => fetch(id);
+
+const parse = function (text: string) {
+  return text ? Number(text) : 0;
+};
+
+function* range(n: number) {
+  for (let i = 0; i < n; i++) {
+    yield i;
+  }
+}
+
+class Counter {
+  count = 0;
+  increment = () => {
+    this.count++;
+  };
+}
+
+export function total(items: number[]) {
+  return items.filter(item => item > 0).length;
+}
+`
+	tests := []struct {
+		name  string
+		class string
+		cyc   int
+		cog   int
+	}{
+		{name: "double", cyc: 1, cog: 1},
+		{name: "load", cyc: 1, cog: 1},
+		{name: "parse", cyc: 2, cog: 2},
+		{name: "range", cyc: 2, cog: 2},
+		{name: "increment", class: "Counter", cyc: 1, cog: 1},
+		{name: "total", cyc: 1, cog: 1},
+		{name: "items.filter callback", cyc: 1, cog: 1},
+	}
+
+	methods := parseSource(t, "functions.ts", source).Methods
+	if len(methods) != len(tests) {
+		t.Fatalf("found %d methods, want %d: %v", len(methods), len(tests), methods)
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method := findMethod(t, methods, test.name)
+			if method.Class != test.class {
+				t.Errorf("class = %q, want %q", method.Class, test.class)
+			}
+			if method.CycCount != test.cyc || method.CogCount != test.cog {
+				t.Errorf("counts = %d/%d, want %d/%d", method.CycCount, method.CogCount, test.cyc, test.cog)
+			}
+		})
+	}
+}
+
+func TestNamesOfCallbacks(t *testing.T) {
+	source := `fetch(url).then(response => response.json());
+fetch<User>(url).then(user => user.name);
+api.get(id)
+  .then(function (r) { return r.data; })
+  .catch(error => log(error));
+promise?.finally(() => done());
+new Promise(resolve => resolve(1));
+`
+	names := []string{
+		"fetch(url).then callback",
+		"fetch<User>(url).then callback",
+		"api.get(id).then callback",
+		"api.get(id).then(function (r) { return r.data; }).catch callback",
+		"promise.finally callback",
+		"new Promise callback",
+	}
+	methods := parseSource(t, "callbacks.ts", source).Methods
+	if len(methods) != len(names) {
+		t.Errorf("found %d methods, want %d: %v", len(methods), len(names), methods)
+	}
+	for _, name := range names {
+		findMethod(t, methods, name)
+	}
+}
+
+func TestParametersOfMethods(t *testing.T) {
+	source := `function typed(a: number, b?: string, c: boolean = true, ...rest: number[]) {}
+
+function untyped(a, b = a * 2, ...rest) {}
+
+function destructured({ x, y }: Point, [first]: number[]) {}
+
+class Account {
+  constructor(private id: string, public owner?: string, balance = 0) {}
+
+  deposit(amount: Map<string, number>, note = new Date()) {}
+}
+
+const square = x => x * x;
+const pair = (key: string, value?: unknown) => [key, value];
+`
+	tests := []struct {
+		name       string
+		parameters []methodInfoType.ParameterInfo
+	}{
+		{name: "typed", parameters: []methodInfoType.ParameterInfo{
+			{Name: "a", Type: "number"},
+			{Name: "b", Type: "string", Optional: true},
+			{Name: "c", Type: "boolean", Optional: true, DefaultValue: "true"},
+			{Name: "rest", Type: "number[]", Rest: true},
+		}},
+		{name: "untyped", parameters: []methodInfoType.ParameterInfo{
+			{Name: "a"},
+			{Name: "b", Optional: true, DefaultValue: "a * 2"},
+			{Name: "rest", Rest: true},
+		}},
+		{name: "destructured", parameters: []methodInfoType.ParameterInfo{
+			{Name: "{ x, y }", Type: "Point"},
+			{Name: "[first]", Type: "number[]"},
+		}},
+		{name: "constructor", parameters: []methodInfoType.ParameterInfo{
+			{Name: "id", Type: "string", Accessibility: "private"},
+			{Name: "owner", Type: "string", Optional: true, Accessibility: "public"},
+			{Name: "balance", Optional: true, DefaultValue: "0"},
+		}},
+		{name: "deposit", parameters: []methodInfoType.ParameterInfo{
+			{Name: "amount", Type: "Map<string, number>"},
+			{Name: "note", Optional: true, DefaultValue: "new Date()"},
+		}},
+		{name: "square", parameters: []methodInfoType.ParameterInfo{
+			{Name: "x"},
+		}},
+		{name: "pair", parameters: []methodInfoType.ParameterInfo{
+			{Name: "key", Type: "string"},
+			{Name: "value", Type: "unknown", Optional: true},
+		}},
+	}
+
+	methods := parseSource(t, "parameters.ts", source).Methods
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method := findMethod(t, methods, test.name)
+			if !reflect.DeepEqual(method.Parameters, test.parameters) {
+				t.Errorf("parameters = %+v\nwant %+v", method.Parameters, test.parameters)
+			}
+		})
+	}
+}
+
+func TestNestedFunctionsAreCountedOnTheirOwn(t *testing.T) {
+	source := `function outer(items: number[]) {
+  if (items.length === 0) {
+    return [];
+  }
+  return items.map(item => {
+    if (item > 0) {
+      return item;
+    }
+    return -item;
+  });
+}
+`
+	methods := parseSource(t, "nested.ts", source).Methods
+	outer := findMethod(t, methods, "outer")
+	callback := findMethod(t, methods, "items.map callback")
+	if outer.CycCount != 2 || outer.CogCount != 2 {
+		t.Errorf("outer counts = %d/%d, want 2/2", outer.CycCount, outer.CogCount)
+	}
+	if callback.CycCount != 2 || callback.CogCount != 2 {
+		t.Errorf("callback counts = %d/%d, want 2/2", callback.CycCount, callback.CogCount)
+	}
+}
+
+func TestImportsOfFile(t *testing.T) {
+	source := `import { Queue } from './queue';
+import _ from 'lodash/fp';
+import * as path from 'path';
+import React, { useState } from 'react';
+import './styles.css';
+export { Stack } from './stack';
+const fs = require('fs');
+`
+	want := []complexCommons.ImportInfo{
+		{Module: "./queue", Line: 1, Kind: complexCommons.ImportKindImport},
+		{Module: "lodash/fp", Line: 2, Kind: complexCommons.ImportKindImport},
+		{Module: "path", Line: 3, Kind: complexCommons.ImportKindImport},
+		{Module: "react", Line: 4, Kind: complexCommons.ImportKindImport},
+		{Module: "./styles.css", Line: 5, Kind: complexCommons.ImportKindImport},
+		{Module: "./stack", Line: 6, Kind: complexCommons.ImportKindExport},
+		{Module: "fs", Line: 7, Kind: complexCommons.ImportKindRequire},
+	}
+
+	path := filepath.Join(t.TempDir(), "imports.ts")
+	if err := os.WriteFile(path, []byte(source), 0666); err != nil {
+		t.Fatal(err)
+	}
+	imports := typescriptComplexityParser{}.ParseFile(path, false).Imports
+	if len(imports) != len(want) {
+		t.Fatalf("imports = %v, want %v", imports, want)
+	}
+	for i, info := range imports {
+		info.FileName = ""
+		if info != want[i] {
+			t.Errorf("import %d = %+v, want %+v", i, info, want[i])
+		}
+	}
+}
+
+func TestCallGraphOfSource(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		cycles [][]string
+		direct []string
+	}{
+		{
+			name:   "direct recursion",
+			source: "function factorial(n: number): number {\n  return n <= 1 ? 1 : n * factorial(n - 1);\n}\n",
+			cycles: [][]string{},
+			direct: []string{"a.ts:factorial"},
+		},
+		{
+			name: "mutual recursion",
+			source: `function isEven(n: number): boolean {
+  return n === 0 ? true : isOdd(n - 1);
+}
+function isOdd(n: number): boolean {
+  return n === 0 ? false : isEven(n - 1);
+}
+`,
+			cycles: [][]string{{"a.ts:isEven", "a.ts:isOdd"}},
+		},
+		{
+			name: "methods calling each other through this",
+			source: `class Walker {
+  walk(node: Node) {
+    this.visit(node);
+  }
+  visit(node: Node) {
+    for (const child of node.children) {
+      this.walk(child);
+    }
+  }
+}
+`,
+			cycles: [][]string{{"a.ts:Walker.visit", "a.ts:Walker.walk"}},
+		},
+		{
+			name: "cycle through call statements",
+			source: `function ping(n: number) {
+  if (n > 0) {
+    pong(n - 1);
+  }
+}
+function pong(n: number) {
+  ping(n);
+}
+`,
+			cycles: [][]string{{"a.ts:ping", "a.ts:pong"}},
+		},
+		{
+			name:   "recursion through a call statement",
+			source: "function countDown(n: number) {\n  console.log(n);\n  if (n > 0) countDown(n - 1);\n}\n",
+			cycles: [][]string{},
+			direct: []string{"a.ts:countDown"},
+		},
+		{
+			name:   "assignment is not a call",
+			source: "let total = 0;\nfunction add(n: number) {\n  total = add2(n);\n}\nfunction add2(n: number) {\n  return n + 2;\n}\n",
+			cycles: [][]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := parseSource(t, "a.ts", test.source).WithFileName("a.ts")
+			graph := complexCommons.BuildCallGraph([]complexCommons.FileCalls{result.Calls})
+			if fmt.Sprint(graph.Cycles) != fmt.Sprint(test.cycles) {
+				t.Errorf("cycles = %v, want %v", graph.Cycles, test.cycles)
+			}
+			direct := []string{}
+			for _, node := range graph.Nodes {
+				if node.DirectRecursion {
+					direct = append(direct, node.ID)
+				}
+			}
+			if fmt.Sprint(direct) != fmt.Sprint(append([]string{}, test.direct...)) {
+				t.Errorf("directly recursive = %v, want %v", direct, test.direct)
+			}
+		})
+	}
+}
+
+func TestDeclarationsOfStatements(t *testing.T) {
+	source := `let count = 0;
+function step(n: number) {
+  return n + 1;
+}
+step(count);
+count = step(count);
+console.log(count);
+`
+	declarations := parseSource(t, "a.ts", source).Declarations.Declarations
+	names := []string{}
+	for _, declaration := range declarations {
+		names = append(names, fmt.Sprintf("%s %s", declaration.Kind, declaration.Name))
+	}
+	// Call statements and assignments do not declare what they use
+	want := []string{"variable count", "function step"}
+	if fmt.Sprint(names) != fmt.Sprint(want) {
+		t.Errorf("declarations = %v, want %v", names, want)
+	}
+}
+
+func TestAsyncIssuesOfSource(t *testing.T) {
+	source := `async function load(url: string): Promise<string> {
+  const response = await fetch(url);
+  return response.text();
+}
+
+function thenWithoutCatch(url: string) {
+  fetch(url).then(response => response.text());
+}
+
+function thenWithCatch(url: string) {
+  fetch(url).then(response => response.text()).catch(error => console.log(error));
+}
+
+function floating(url: string) {
+  load(url);
+}
+
+async function awaited(url: string) {
+  await load(url);
+  void load(url);
+  return load(url);
+}
+
+async function inLoop(urls: string[]) {
+  for (const url of urls) {
+    await load(url);
+  }
+}
+`
+	tests := []struct {
+		method string
+		kinds  []string
+		awaits int
+		async  bool
+	}{
+		{method: "load", awaits: 1, async: true},
+		{method: "thenWithoutCatch", kinds: []string{methodInfoType.AsyncIssueMissingCatch}},
+		{method: "thenWithCatch"},
+		{method: "floating", kinds: []string{methodInfoType.AsyncIssueNotAwaited}},
+		{method: "awaited", awaits: 1, async: true},
+		{method: "inLoop", kinds: []string{methodInfoType.AsyncIssueAwaitInLoop}, awaits: 1, async: true},
+	}
+
+	methods := parseSource(t, "async.ts", source).Methods
+	for _, test := range tests {
+		t.Run(test.method, func(t *testing.T) {
+			info := findMethod(t, methods, test.method).Async
+			kinds := []string{}
+			for _, issue := range info.Issues {
+				kinds = append(kinds, issue.Kind)
+			}
+			if fmt.Sprint(kinds) != fmt.Sprint(append([]string{}, test.kinds...)) {
+				t.Errorf("issues = %v, want %v", info.Issues, test.kinds)
+			}
+			if info.AwaitCount != test.awaits || info.IsAsync != test.async {
+				t.Errorf("awaits = %d, async = %t, want %d, %t", info.AwaitCount, info.IsAsync, test.awaits, test.async)
+			}
+		})
+	}
+}
+
+func TestModernSyntaxOfSource(t *testing.T) {
+	source := `type ElementOf<T> = T extends (infer U)[] ? U : never;
+type Unwrap<T> = T extends Promise<infer V> ? V : T;
+type Getter<K extends string> = ` + "`get${Capitalize<K>}`" + `;
+type Pair = ` + "`${string}-${number}`" + `;
+type Keys = keyof { a: 1 };
+const config = { port: 8080 } satisfies Record<string, number>;
+
+class Base {
+  describe(): string { return 'base'; }
+}
+
+class Counter extends Base {
+  #count = 0;
+  #inc(by: number) { this.#count += by; }
+  override describe(): string { return ` + "`count ${this.#count}`" + `; }
+  settle(options: any) {
+    options.a ??= 1;
+    options.b ||= 2;
+    options.c &&= 3;
+    options.d |= 4;
+    this.#inc(options?.step ?? 1);
+  }
+}
+`
+	tests := []struct {
+		name string
+		cyc  int
+		cog  int
+	}{
+		{name: "#inc", cyc: 1, cog: 1},
+		{name: "describe", cyc: 1, cog: 1},
+		{name: "settle", cyc: 6, cog: 6}, // ??=, ||=, &&=, ?. and ?? each add one, |= does not
+	}
+
+	methods := parseSource(t, "counter.ts", source).Methods
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method := findMethod(t, methods, test.name)
+			if method.CycCount != test.cyc || method.CogCount != test.cog {
+				t.Errorf("counts = %d/%d, want %d/%d", method.CycCount, method.CogCount, test.cyc, test.cog)
+			}
+		})
+	}
+}
@@ -4,12 +4,12 @@ import (
 	"SubmissionGrader/internal/common"
 	"SubmissionGrader/internal/complexity/complexCommons"
 	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
-	"SubmissionGrader/internal/complexity/scopeInfo"
-	"SubmissionGrader/internal/complexity/stateInfo"
 	parser "SubmissionGrader/internal/complexity/typescript/typeScriptAntlrParser"
+	"context"
 	"fmt"
-	//"github.com/antlr4-go/antlr/v4"
-	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"strings"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
 )
 
 func CreateTypescriptComplexityParser() complexCommons.IComplexityParser {
@@ -26,362 +26,218 @@ func (c typescriptComplexityParser) GetFileRegex() string {
 	return c.fileRegex
 }
 
+// ParseComplexityOfFile
+// Parses the file into a TypeScriptParser tree and walks it to find every
+// method along with its cyclomatic and cognitive complexity.
+// Files that could not be read, had syntax errors or were abandoned are logged, ParseFile tells them apart
 func (c typescriptComplexityParser) ParseComplexityOfFile(filename string, includeTokens bool) []methodInfoType.MethodInfo {
-	fileText, _ := common.GetTextOfFile(filename)
-
-	input := antlr.NewInputStream(fileText)
-	lexer := parser.NewTypeScriptLexer(input)
-
-	stateStack := stateInfo.NewStateStack()
-	currentState := stateInfo.NewStateObject()
-	finalStack := methodInfoType.NewMethodStack()
-
-	bracketCount := 0
-	repeatForLoopForExtraCheck := true
+	result := c.ParseFile(filename, includeTokens)
+	switch result.Status {
+	case complexCommons.ParseStatusUnreadable:
+		common.Error(fmt.Sprintf("Failed to read file for complexity: %s: %s", filename, result.Err))
+	case complexCommons.ParseStatusAbandoned:
+		common.Error(fmt.Sprintf("Gave up parsing file for complexity: %s: %s", filename, result.Err))
+	case complexCommons.ParseStatusSyntaxErrors:
+		first := result.Diagnostics[0]
+		common.Warning(fmt.Sprintf("File parsed for complexity has %d syntax errors, the first at %s:%d:%d: %s", len(result.Diagnostics), filename, first.Line, first.Column, first.Message))
+	}
+	return result.Methods
+}
 
-	prevFoundToken := ""
-	potentialClassOverride := ""
-	scopeCurlyBracketCheck := false
-	overrideScopePop := false
-	immediatelyGoOutOfScope := false
-	inTernaryOperatorScope := false
-	inTernaryOperatorOriginalNesting := -1
+// ParseFile
+// Same as ParseComplexityOfFile, but also says whether the file could be read and parsed,
+// along with every syntax error found. What was found before and after a syntax error is still given.
+// Everything the grader looks at in a file is gathered from this one walk of its tree:
+// the lines with code, the classes, imports, calls and declarations, and the normalised tokens of every method and of the file
+func (c typescriptComplexityParser) ParseFile(filename string, includeTokens bool) complexCommons.FileParseResult {
+	listener, tokenStream, result := c.walkFile(filename, includeTokens)
+	if listener == nil {
+		return result
+	}
 
-	t, b := MoveToNextToken(lexer, includeTokens, currentState.CurrentMethodInfo, currentState.InMethod)
-	if !b {
-		common.Warning(fmt.Sprintf("File attempting to be parsed for complexity was empty: %s", filename))
-		return finalStack.ConvertToArray()
+	result.Methods = listener.GetMethods()
+	codeLines := getLinesWithCode(tokenStream)
+	result.SLOC = len(codeLines)
+	for className, spans := range listener.classSpans {
+		result.ClassSLOC[className] = countLinesInSpans(codeLines, spans)
+	}
+	result.Classes = listener.GetClasses()
+	result.Imports = listener.GetImports()
+	result.MethodTokens = listener.GetMethodTokens()
+	if includeTokens {
+		result.Tokens = getNormalizedTokens(tokenStream)
 	}
-	textName := t.GetText()
-	symbolicName := lexer.SymbolicNames[t.GetTokenType()]
-	lastItemWasRecursive := false
 
-	for {
-		prevFoundToken = symbolicName
-		if !repeatForLoopForExtraCheck {
-			t, b = MoveToNextToken(lexer, includeTokens, currentState.CurrentMethodInfo, currentState.InMethod)
-			if !b {
-				return finalStack.ConvertToArray()
-			}
-		} else {
-			repeatForLoopForExtraCheck = false
+	result.Calls.Functions = listener.GetFunctions()
+	result.Calls.Calls = listener.GetCalls()
+	for _, class := range result.Classes {
+		if class.Extends != "" {
+			result.Calls.Extends[class.Name] = class.Extends
 		}
-		textName = t.GetText()
-		symbolicName = lexer.SymbolicNames[t.GetTokenType()]
-
-		if immediatelyGoOutOfScope {
-			currentState.CurrentScope.ScopeBracketCount = bracketCount
-			immediatelyGoOutOfScope = false
-		} else if symbolicName == "LeftBrace" {
-			bracketCount++
-		} else if scopeCurlyBracketCheck && ((prevFoundToken != "Else" && symbolicName != "If") || prevFoundToken == "Do") {
-			repeatForLoopForExtraCheck = true
-		} else if symbolicName == "RightBrace" {
-			bracketCount--
-		} else if symbolicName == "AndAnd" && currentState.InMethod {
-			currentState.CurrentMethodInfo.CycCount++
-		} else if symbolicName == "OrOr" && currentState.InMethod {
-			currentState.CurrentMethodInfo.CycCount++
-		} else if symbolicName == "Class" {
-			// We need to first ensure we actually have found a class
-			t, b = MoveToNextToken(lexer, includeTokens, currentState.CurrentMethodInfo, currentState.InMethod) // gets to next identifier
-			if !b {
-				return finalStack.ConvertToArray()
-			}
-			tempSN := lexer.SymbolicNames[t.GetTokenType()]
-			if tempSN == "Identifier" {
-				potentialClassName := t.GetText()
-				t, b = MoveToNextToken(lexer, includeTokens, currentState.CurrentMethodInfo, currentState.InMethod) // gets to next identifier
-				if !b {
-					return finalStack.ConvertToArray()
-				}
-				tempSN = lexer.SymbolicNames[t.GetTokenType()]
-				if tempSN == "LeftBrace" { // Yay, it is a class
-					if currentState.InClass {
-						newLocation := currentState.Location + "->" + currentState.ClassName
-						if currentState.InMethod {
-							newLocation += "." + currentState.CurrentMethodInfo.MethodName
-						}
-						stateStack.Push(currentState)
-						currentState = stateInfo.NewStateObjectWithLocation(newLocation)
-					}
+	}
 
-					currentState.InClass = true
-					currentState.ClassBracketCount = bracketCount
-					currentState.ClassName = potentialClassName
-					bracketCount++
-				} else {
-					repeatForLoopForExtraCheck = true
-				}
-			} else {
-				repeatForLoopForExtraCheck = true
-			}
-		} else if symbolicName == "Identifier" && !currentState.InMethod {
-			potentialMethodName := t.GetText()
-			potentialParameter := ""
-			t, b = MoveToNextToken(lexer, includeTokens, currentState.CurrentMethodInfo, currentState.InMethod)
-			if !b {
-				return finalStack.ConvertToArray()
-			}
-			tempSN := lexer.SymbolicNames[t.GetTokenType()]
-			if tempSN == "LeftParen" { // SHOULD FIND (
-				potentialCycCount, content := SkipParenthesisGetInside(lexer)
-				potentialParameter = content
-				if potentialCycCount == -1 {
-					return finalStack.ConvertToArray()
-				}
-				t, b = MoveToNextToken(lexer, includeTokens, currentState.CurrentMethodInfo, currentState.InMethod)
-				if !b {
-					return finalStack.ConvertToArray()
-				}
-				tempSN = lexer.SymbolicNames[t.GetTokenType()]
-				if tempSN == "LeftBrace" { // SHOULD FIND {
-					// METHOD FOUND!
-					if prevFoundToken == "Tilde" {
-						potentialMethodName = "~" + potentialMethodName
-					}
-					tempClassName := currentState.ClassName
-					if potentialClassOverride != "" {
-						tempClassName = potentialClassOverride
-						potentialClassOverride = ""
-					}
+	result.Declarations.Declarations = listener.GetDeclarations()
+	result.Declarations.Unreachable = listener.GetUnreachable()
+	for _, token := range tokenStream.GetAllTokens() {
+		if token.GetChannel() == antlr.TokenDefaultChannel && token.GetTokenType() != antlr.TokenEOF {
+			result.Declarations.References[token.GetText()]++
+		}
+	}
+	for name, count := range listener.jsxNames {
+		result.Declarations.References[name] += count
+	}
+	if len(listener.jsxNames) > 0 { // JSX compiles to React.createElement
+		result.Declarations.References["React"]++
+	}
+	return result.WithFileName(filename)
+}
 
-					newMethod := methodInfoType.MethodInfo{
-						Location:           "",
-						Class:              tempClassName,
-						MethodName:         potentialMethodName,
-						Parameter:          potentialParameter,
-						StartLine:          t.GetLine(),
-						EndLine:            -1,
-						TotalLine:          -1,
-						LinesOfCodeCreated: -1,
-						CogCount:           1,
-						CycCount:           1,
-					}
-					currentState.CurrentMethodInfo = &newMethod
-					currentState.MethodBracketCount = bracketCount
-					currentState.InMethod = true
-					currentState.NestingCount = 0
+// walkFile
+// Parses the file and walks the tree with the complexity listener.
+// Returns nil if the file is empty, could not be read or could not be parsed within parseLimits,
+// with the result saying which
+func (c typescriptComplexityParser) walkFile(filename string, includeTokens bool) (*typescriptComplexityListener, *antlr.CommonTokenStream, complexCommons.FileParseResult) {
+	result := complexCommons.NewFileParseResult(filename)
+	source, status, err := readSource(filename)
+	if status != complexCommons.ParseStatusParsed {
+		result.Status = status
+		result.Err = err
+		return nil, nil, result
+	}
 
-					bracketCount++
-				}
-			} else if tempSN == "DoubleColon" || tempSN == "Less" {
-				potentialClassOverride = textName
-			} else if tempSN == "LeftBrace" {
-				repeatForLoopForExtraCheck = true
-			} else if tempSN == "Identifier" {
-				repeatForLoopForExtraCheck = true
-			}
-		} else if symbolicName == "If" && currentState.InMethod { // NESTING MATTERS
-			currentState.LastScopeKeyWord = "If"
-			currentState.IncCycCount(1)
-			if prevFoundToken != "Else" {
-				currentState.AddToComplexitiesWithNesting(0, 1)
-			}
-			potentialCycloCount := FindAndSkipParenthesis(lexer, lexer.NextToken()) // There should be a set of parenthesis following IF
-			if potentialCycloCount == -1 {
-				return finalStack.ConvertToArray()
-			}
-			currentState.IncCycCount(potentialCycloCount)
-			scopeCurlyBracketCheck = true
-			continue
-		} else if symbolicName == "Else" && currentState.InMethod { // NESTING MATTERS
-			currentState.LastScopeKeyWord = "Else"
-			currentState.AddToComplexitiesAndIncreaseNesting(0, 1)
-			scopeCurlyBracketCheck = true
-			continue
-		} else if symbolicName == "For" && currentState.InMethod { // NESTING MATTERS
-			worked := AddItemAsScoped(&currentState, lexer, true, &scopeCurlyBracketCheck, &prevFoundToken, symbolicName, 1, 1)
-			if !worked {
-				return finalStack.ConvertToArray()
-			}
-			continue
-		} else if symbolicName == "While" && currentState.InMethod { // NESTING MATTERS
-			worked := AddItemAsScoped(&currentState, lexer, true, &scopeCurlyBracketCheck, &prevFoundToken, symbolicName, 1, 1)
-			if !worked {
-				return finalStack.ConvertToArray()
-			}
-			continue
-		} else if symbolicName == "Do" && currentState.InMethod { // NESTING MATTERS
-			currentState.LastScopeKeyWord = "Do"
-			worked := AddItemAsScoped(&currentState, lexer, false, &scopeCurlyBracketCheck, &prevFoundToken, symbolicName, 1, 1)
-			if !worked {
-				return finalStack.ConvertToArray()
-			}
-			continue
-		} else if symbolicName == "Switch" && currentState.InMethod { // NESTING MATTERS
-			worked := AddItemAsScoped(&currentState, lexer, true, &scopeCurlyBracketCheck, &prevFoundToken, symbolicName, 0, 1)
-			if !worked {
-				return finalStack.ConvertToArray()
-			}
-			continue
-		} else if symbolicName == "Case" && currentState.InMethod {
-			currentState.IncCycCount(1)
-		} else if symbolicName == "Default" && currentState.InMethod {
-			currentState.IncCycCount(1)
-		} else if symbolicName == "Break" && currentState.InMethod {
+	errorListener := newSyntaxErrorListener(filename)
+	lexer := newSourceLexer(source)
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errorListener)
+	ctx, cancel := context.WithTimeout(context.Background(), parseLimits.timeout)
+	defer cancel()
+	tokenStream := newBoundedTokenStream(ctx, lexer)
+	tsParser := parser.NewTypeScriptParser(tokenStream)
+	tsParser.RemoveErrorListeners()
+	tsParser.AddErrorListener(errorListener)
+
+	tree, err := parseProgram(tsParser, tokenStream)
+	if abandoned, ok := err.(parseAbandoned); ok {
+		result.Status = complexCommons.ParseStatusAbandoned
+		result.Err = abandoned
+		result.Diagnostics = append(errorListener.getDiagnostics(), complexCommons.Diagnostic{
+			FileName: filename,
+			Line:     abandoned.token.GetLine(),
+			Column:   abandoned.token.GetColumn() + 1,
+			Message:  abandoned.Error(),
+		})
+		return nil, nil, result
+	}
+	listener := newTypescriptComplexityListener(lexer.TypeScriptLexer, includeTokens, source)
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	errorListener.addUnexpectedCharacters(tokenStream.GetAllTokens())
+	result.Status = complexCommons.ParseStatusParsed
+	if diagnostics := errorListener.getDiagnostics(); len(diagnostics) > 0 {
+		result.Status = complexCommons.ParseStatusSyntaxErrors
+		result.Diagnostics = diagnostics
+	}
+	return listener, tokenStream.CommonTokenStream, result
+}
 
-		} else if symbolicName == "Question" && currentState.InMethod { // This is for Ternary Operators
-			if !inTernaryOperatorScope {
-				inTernaryOperatorScope = true
-				inTernaryOperatorOriginalNesting = currentState.NestingCount
-			}
-			currentState.AddToComplexitiesWithNesting(1, 1)
+// ParseNormalizedTokensOfFile
+// Lexes the file into the tokens used to fingerprint it for complexCommons.FingerprintFile
+func (c typescriptComplexityParser) ParseNormalizedTokensOfFile(filename string) []complexCommons.NormalizedToken {
+	source, status, _ := readSource(filename)
+	if status != complexCommons.ParseStatusParsed {
+		return []complexCommons.NormalizedToken{}
+	}
 
-		} else if symbolicName == "LeftBracket" && currentState.InMethod { // [
-			MoveToNextSpecifiedToken(lexer, "RightBracket", includeTokens, currentState.CurrentMethodInfo, currentState.InMethod)
-			t = lexer.NextToken()
-			tempSN := lexer.SymbolicNames[t.GetTokenType()]
-			if tempSN == "LeftParen" {
-				SkipParenthesis(lexer)
-				t = lexer.NextToken()
-				tempSN = lexer.SymbolicNames[t.GetTokenType()]
-			}
-			if tempSN == "LeftBrace" {
-				currentState.LastScopeKeyWord = "Lambda_Function"
-				symbolicName = "LeftBrace"
-				bracketCount++
-				currentState.AddToComplexitiesAndIncreaseNesting(1, 1)
-				scopeCurlyBracketCheck = true
-			}
-		} else if symbolicName == "RightBracket" && currentState.InMethod { // ]
+	lexer := newSourceLexer(source)
+	lexer.RemoveErrorListeners()
+	tokenStream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	tokenStream.Fill()
+	return getNormalizedTokens(tokenStream)
+}
 
-		} else if symbolicName == "Try" && currentState.InMethod {
-			currentState.LastScopeKeyWord = "Try"
-			scopeCurlyBracketCheck = true
-			continue
-		} else if symbolicName == "Catch" && currentState.InMethod { // NESTING MATTERS
-			worked := AddItemAsScoped(&currentState, lexer, true, &scopeCurlyBracketCheck, &prevFoundToken, symbolicName, 1, 1)
-			if !worked {
-				return finalStack.ConvertToArray()
-			}
+// getNormalizedTokens
+// The tokens of the default channel, normalised so renamed copies still match
+func getNormalizedTokens(tokenStream *antlr.CommonTokenStream) []complexCommons.NormalizedToken {
+	tokens := []complexCommons.NormalizedToken{}
+	for _, token := range tokenStream.GetAllTokens() {
+		if token.GetChannel() != antlr.TokenDefaultChannel || token.GetTokenType() == antlr.TokenEOF {
 			continue
-		} else if symbolicName == "Throw" && currentState.InMethod {
-			currentState.IncCycCount(1)
-		} else if symbolicName == "Semi" && currentState.InMethod {
-			if inTernaryOperatorScope {
-				inTernaryOperatorScope = false
-				currentState.NestingCount = inTernaryOperatorOriginalNesting
-			}
-			if currentState.InScope {
-				potentialClassOverride = ""
-				if currentState.CurrentScope.DependentScope {
-					currentState.CurrentScope.ScopeBracketCount = bracketCount
-				}
-			}
-		} else if symbolicName == "DoubleColon" && currentState.InMethod { // ::
-
-		} else if symbolicName == "Less" { // "<"
-
-		} else if symbolicName == "Greater" { // ">"
-
-		} else if symbolicName == "LeftParen" { // "("
-			if lastItemWasRecursive {
-				currentState.IncCogCount(1)
-			}
-		} else if symbolicName == "RightParen" { // ")"
-
-		} else if currentState.InMethod {
-			if textName == currentState.CurrentMethodInfo.MethodName {
-				lastItemWasRecursive = true
-				continue
-			}
 		}
-		lastItemWasRecursive = false
+		tokens = append(tokens, complexCommons.NormalizedToken{Text: normalizeToken(token), Original: token.GetText(), Line: token.GetLine()})
+	}
+	return tokens
+}
 
-		if scopeCurlyBracketCheck { // Creates scope for item
-			if currentState.InScope {
-				currentState.CurrentScopeStack.Push(*currentState.CurrentScope)
-			}
+// readSource
+// Reads the file and rewrites what the generated lexer does not know.
+// The status is ParseStatusParsed when there is source to parse
+func readSource(filename string) (rewrittenSource, string, error) {
+	fileText, err := common.GetTextOfFile(filename)
+	if err != nil {
+		return rewrittenSource{}, complexCommons.ParseStatusUnreadable, err
+	}
+	if strings.TrimSpace(fileText) == "" {
+		common.Warning(fmt.Sprintf("File attempting to be parsed for complexity was empty: %s", filename))
+		return rewrittenSource{}, complexCommons.ParseStatusEmpty, nil
+	}
 
-			deScope := false
+	var jsxNames map[string]int
+	if strings.HasSuffix(filename, ".tsx") || strings.HasSuffix(filename, ".jsx") {
+		fileText, jsxNames = rewriteJsx(fileText)
+	}
+	source := rewriteModernSyntax(fileText)
+	source.jsxNames = jsxNames
+	return source, complexCommons.ParseStatusParsed, nil
+}
 
-			if symbolicName == "LeftBrace" {
-				currentState.CurrentScope = scopeInfo.NewScopeInfo(false, bracketCount-1, currentState.LastScopeKeyWord, false)
-			} else {
+// normalizeToken
+// Names and literal values are replaced by their kind, everything else keeps its text
+func normalizeToken(token antlr.Token) string {
+	switch token.GetTokenType() {
+	case parser.TypeScriptLexerIdentifier:
+		return "$id"
+	case parser.TypeScriptLexerStringLiteral,
+		parser.TypeScriptLexerTemplateStringAtom,
+		parser.TypeScriptLexerTemplateStringEscapeAtom:
+		return "$string"
+	case parser.TypeScriptLexerDecimalLiteral,
+		parser.TypeScriptLexerHexIntegerLiteral,
+		parser.TypeScriptLexerOctalIntegerLiteral,
+		parser.TypeScriptLexerOctalIntegerLiteral2,
+		parser.TypeScriptLexerBinaryIntegerLiteral:
+		return "$number"
+	case parser.TypeScriptLexerRegularExpressionLiteral:
+		return "$regex"
+	case parser.TypeScriptLexerBooleanLiteral:
+		return "$boolean"
+	}
+	return token.GetText()
+}
 
-				if currentState.LastScopeKeyWord == "Do" {
-					deScope = true
-				}
-				currentState.CurrentScope = scopeInfo.NewScopeInfo(true, -1, currentState.LastScopeKeyWord, deScope)
-			}
-			currentState.InScope = true
-			scopeCurlyBracketCheck = false
+// getLinesWithCode
+// Comments and whitespace are on the hidden channel, so any line holding a token
+// from the default channel has code on it
+func getLinesWithCode(tokenStream *antlr.CommonTokenStream) map[int]bool {
+	lines := map[int]bool{}
+	for _, token := range tokenStream.GetAllTokens() {
+		if token.GetChannel() == antlr.TokenDefaultChannel && token.GetTokenType() != antlr.TokenEOF {
+			lines[token.GetLine()] = true
 		}
+	}
+	return lines
+}
 
-		if bracketCount == currentState.CurrentScope.ScopeBracketCount { // this Scope is over
-			t, b = MoveToNextToken(lexer, includeTokens, currentState.CurrentMethodInfo, currentState.InMethod)
-			if !b {
-				return finalStack.ConvertToArray()
-			}
-			repeatForLoopForExtraCheck = true
-			symbolicNameTemp := lexer.SymbolicNames[t.GetTokenType()]
-			if currentState.CurrentScope.ScopeKeyword == "If" {
-				if symbolicNameTemp == "Else" {
-					overrideScopePop = true
-				}
-			} else if currentState.CurrentScope.ScopeKeyword == "Do" {
-				potentialCycloCount := FindAndSkipParenthesis(lexer, t) // There should be a set of parenthesis following WHILE (if not do while)
-				if potentialCycloCount == -1 {
-					return finalStack.ConvertToArray()
-				} else {
-					currentState.IncCycCount(potentialCycloCount)
-				}
-				t, b = MoveToNextToken(lexer, includeTokens, currentState.CurrentMethodInfo, currentState.InMethod)
-				if !b {
-					return finalStack.ConvertToArray()
-				}
-			} else if currentState.CurrentScope.ScopeKeyword == "Try" {
-				overrideScopePop = true
-				currentState.NestingCount++
-			}
-
-			currentState.NestingCount--
-			if !currentState.CurrentScopeStack.Empty() {
-				poppingResult := -2 // SHOULD NEVER END UP WITH -2 AS FINAL RESULT
-				if !overrideScopePop {
-					poppingResult = currentState.PopScopeStackUntilNonDependentScope()
-				} else {
-					overrideScopePop = false
-					poppingResult = 1
-				}
-
-				if poppingResult == -1 { // ERROR
-					return finalStack.ConvertToArray()
-				} else if poppingResult == 0 { // Empty stack
-					currentState.FlushScope()
-				} else if poppingResult == 1 || poppingResult == 2 { // Grab the previous item now
-					prevScope, err := currentState.CurrentScopeStack.Front()
-					if err != nil {
-						common.Error(fmt.Sprintf("Failed to get previous scope: %s\n", err))
-						return finalStack.ConvertToArray()
-					}
-					currentState.CurrentScope = &prevScope
-					err = currentState.CurrentScopeStack.Pop()
-					if err != nil {
-						common.Error(fmt.Sprintf("Failed to pop previous scope: %s\n", err))
-						return finalStack.ConvertToArray()
-					}
-					if poppingResult == 2 {
-						immediatelyGoOutOfScope = true // THE IMPORTANT DIFFERENT
-					}
-				}
-			} else {
-				currentState.FlushScope()
-			}
-		} else if bracketCount == currentState.MethodBracketCount { // this method is over
-			// Finishes up adding necessary items to method info object and puts it on stack
-			methodEndLine := t.GetLine()
-			b := complexCommons.FinishMethod(&currentState, finalStack, methodEndLine)
-			if !b {
-				return finalStack.ConvertToArray()
-			}
-			potentialClassOverride = ""
-		} else if bracketCount == currentState.ClassBracketCount { // this Class is over
-			b := complexCommons.RestorePreviousState(&currentState, stateStack)
-			if !b {
-				return finalStack.ConvertToArray()
+// countLinesInSpans
+// Counts the lines with code that fall in any of the spans, counting each line once
+func countLinesInSpans(codeLines map[int]bool, spans []lineSpan) int {
+	count := 0
+	for line := range codeLines {
+		for _, span := range spans {
+			if line >= span.start && line <= span.end {
+				count++
+				break
 			}
 		}
 	}
+	return count
 }
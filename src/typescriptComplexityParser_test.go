@@ -0,0 +1,251 @@
+package typescript
+
+import (
+	"SubmissionGrader/internal/complexity/complexCommons"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestComplexityAnalyzerCache(t *testing.T) {
+	source := `import { Shape } from './shape';
+
+export class Square extends Shape {
+  area(side: number): number {
+    return side > 0 ? side * side : 0;
+  }
+}
+`
+	directory := t.TempDir()
+	first := filepath.Join(directory, "first", "square.ts")
+	second := filepath.Join(directory, "second", "square.ts")
+	broken := filepath.Join(directory, "broken.ts")
+	for path, content := range map[string]string{first: source, second: source, broken: "const a = 1 +;\nfunction g() { return 2; }\n"} {
+		if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	missing := filepath.Join(directory, "missing.ts")
+
+	parser := CreateTypescriptComplexityParser().(complexCommons.FileParser)
+	cache := filepath.Join(directory, "cache")
+	analyzer := complexCommons.NewComplexityAnalyzer(parser, 1, cache)
+	analyzed := analyzer.AnalyzeFiles([]string{first, second, missing, broken}, false)
+
+	tests := []struct {
+		name   string
+		file   complexCommons.AnalyzedFile
+		path   string
+		status string
+		cached bool
+	}{
+		{name: "parsed", file: analyzed[0], path: first, status: complexCommons.ParseStatusParsed, cached: false},
+		{name: "same content under another name", file: analyzed[1], path: second, status: complexCommons.ParseStatusParsed, cached: true},
+		{name: "missing", file: analyzed[2], path: missing, status: complexCommons.ParseStatusUnreadable, cached: false},
+		{name: "syntax errors", file: analyzed[3], path: broken, status: complexCommons.ParseStatusSyntaxErrors, cached: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.file.Result
+			if result.FileName != test.path || result.Status != test.status || test.file.Cached != test.cached {
+				t.Errorf("%s is %s, cached %t, want %s, %s, cached %t", result.FileName, result.Status, test.file.Cached, test.path, test.status, test.cached)
+			}
+		})
+	}
+
+	// Everything found in the file comes back from the cache, named after the file that was asked for
+	if cached, parsed := analyzed[1].Result, parser.ParseFile(second, false); !reflect.DeepEqual(cached, parsed) {
+		t.Errorf("cached result = %+v\nwant %+v", cached, parsed)
+	}
+	if analyzed[2].Result.Err == nil {
+		t.Error("missing file has no error")
+	}
+	if stats := analyzer.GetCacheStats(); stats != (complexCommons.CacheStats{Files: 4, Hits: 1, Misses: 2, Failed: 1}) {
+		t.Errorf("stats = %+v, want 4 files, 1 hit, 2 misses and 1 failed", stats)
+	}
+
+	// Another analyzer sharing the cache finds the file with syntax errors, but not the missing one
+	again := complexCommons.NewComplexityAnalyzer(parser, 2, cache).AnalyzeFiles([]string{broken, missing}, false)
+	if !again[0].Cached || again[0].Result.Diagnostics[0].FileName != broken {
+		t.Errorf("file with syntax errors = %+v, want it cached with its diagnostics", again[0])
+	}
+	if again[1].Cached {
+		t.Error("missing file was cached")
+	}
+
+	// Tokens are only in results that asked for them
+	withTokens := complexCommons.NewComplexityAnalyzer(parser, 1, cache).AnalyzeFiles([]string{first}, true)
+	if withTokens[0].Cached || len(withTokens[0].Result.Methods[0].Tokens) == 0 {
+		t.Errorf("result with tokens = %+v, want it parsed again with tokens", withTokens[0])
+	}
+	fileTokens := typescriptComplexityParser{}.ParseNormalizedTokensOfFile(first)
+	if !reflect.DeepEqual(withTokens[0].Result.Tokens, fileTokens) {
+		t.Errorf("tokens of the file = %v, want %v", withTokens[0].Result.Tokens, fileTokens)
+	}
+
+	if off := complexCommons.NewComplexityAnalyzer(parser, 1, "").AnalyzeFiles([]string{first}, false); off[0].Cached {
+		t.Error("result was cached with the cache turned off")
+	}
+}
+
+func TestComplexityAnalyzerCacheIsPrivate(t *testing.T) {
+	directory := t.TempDir()
+	path := filepath.Join(directory, "square.ts")
+	if err := os.WriteFile(path, []byte("function square(x: number) {\n  return x * x;\n}\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	parser := CreateTypescriptComplexityParser().(complexCommons.FileParser)
+	analyze := func(cache string) complexCommons.AnalyzedFile {
+		return complexCommons.NewComplexityAnalyzer(parser, 1, cache).AnalyzeFiles([]string{path}, false)[0]
+	}
+
+	cache := filepath.Join(directory, "cache")
+	analyze(cache)
+	if info, err := os.Stat(cache); err != nil || info.Mode().Perm() != 0700 {
+		t.Fatalf("cache directory = %v, %v, want it made with 0700", info, err)
+	}
+	if !analyze(cache).Cached {
+		t.Fatal("result was not cached")
+	}
+
+	// An entry others can write to may have been planted
+	entries, err := filepath.Glob(filepath.Join(cache, "*.json"))
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("cache entries = %v, %v, want one", entries, err)
+	}
+	if err := os.Chmod(entries[0], 0666); err != nil {
+		t.Fatal(err)
+	}
+	if analyze(cache).Cached {
+		t.Error("entry others can write to was read")
+	}
+
+	// So may anything in a directory others can write to
+	shared := filepath.Join(directory, "shared")
+	if err := os.Mkdir(shared, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(shared, 0777); err != nil {
+		t.Fatal(err)
+	}
+	analyze(shared)
+	if analyze(shared).Cached {
+		t.Error("directory others can write to was used as the cache")
+	}
+}
+
+func TestParseFileFinishes(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		status  string
+		methods []string
+	}{
+		{name: "trailing comma of parameters", source: "function g(a: number,) {}", status: complexCommons.ParseStatusParsed, methods: []string{"g"}},
+		{name: "trailing comma of type parameters", source: "function f<T,>(x: T) {}", status: complexCommons.ParseStatusParsed, methods: []string{"f"}},
+		{name: "trailing comma of arrow function", source: "const h = (a, b,) => a + b;", status: complexCommons.ParseStatusParsed, methods: []string{"h"}},
+		{name: "missing expression", source: "let x = ;", status: complexCommons.ParseStatusSyntaxErrors},
+		{name: "truncated parameters", source: "function bad( { if (x) }", status: complexCommons.ParseStatusSyntaxErrors},
+		{name: "truncated class", source: "class A { m() {}", status: complexCommons.ParseStatusSyntaxErrors},
+		{name: "truncated in the middle of a method", source: "class A {\n  m(a: number) {\n    if (a > 1) {\n      return a", status: complexCommons.ParseStatusSyntaxErrors},
+		{name: "truncated tuple type", source: "type P = [a: number,", status: complexCommons.ParseStatusSyntaxErrors},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "file.ts")
+			if err := os.WriteFile(path, []byte(test.source), 0666); err != nil {
+				t.Fatal(err)
+			}
+			start := time.Now()
+			result := typescriptComplexityParser{}.ParseFile(path, false)
+			if took := time.Since(start); took > parseLimits.timeout {
+				t.Errorf("parsing took %s", took)
+			}
+			if result.Status != test.status {
+				t.Fatalf("status = %s, want %s: %v", result.Status, test.status, result.Diagnostics)
+			}
+			if test.status == complexCommons.ParseStatusSyntaxErrors && len(result.Diagnostics) == 0 {
+				t.Error("no diagnostics for a file with syntax errors")
+			}
+			names := []string{}
+			for _, method := range result.Methods {
+				names = append(names, method.MethodName)
+			}
+			if len(test.methods) > 0 && !reflect.DeepEqual(names, test.methods) {
+				t.Errorf("methods = %v, want %v", names, test.methods)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsOfCommonErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		line    int
+		column  int
+		methods []string
+	}{
+		{name: "missing closing brace", source: "function g() { return 2; }\nfunction f() {\n  return 1;\n", line: 4, column: 1, methods: []string{"g"}},
+		{name: "missing closing parenthesis", source: "function f(a: number {\n  return a;\n}\n", line: 1, column: 22},
+		{name: "unterminated string", source: "function f() {\n  return 1;\n}\nconst s = \"abc;\n", line: 4, column: 11, methods: []string{"f"}},
+		{name: "missing operand", source: "const a = 1 +;\nfunction g() { return 2; }\n", line: 1, column: 14, methods: []string{"g"}},
+		{name: "stray character", source: "function f() {\n  return 1 @ 2;\n}\n", line: 2, column: 12},
+		{name: "unclosed array", source: "function f() {\n  return [1, 2;\n}\n", line: 2, column: 3},
+		{name: "unclosed parameters of a method", source: "class A {\n  m() { return 1; }\n  n(\n}\n", line: 4, column: 1},
+		{name: "doubled comma", source: "function f() {\n  return {a: 1,, b: 2};\n}\n", line: 2, column: 3},
+		{name: "unclosed comment", source: "/* note\nfunction f() {}\n", line: 1, column: 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "file.ts")
+			if err := os.WriteFile(path, []byte(test.source), 0666); err != nil {
+				t.Fatal(err)
+			}
+			result := typescriptComplexityParser{}.ParseFile(path, false)
+			if result.Status != complexCommons.ParseStatusSyntaxErrors || len(result.Diagnostics) == 0 {
+				t.Fatalf("status = %s with %v, want %s", result.Status, result.Diagnostics, complexCommons.ParseStatusSyntaxErrors)
+			}
+			first := result.Diagnostics[0]
+			if first.Line != test.line || first.Column != test.column {
+				t.Errorf("first diagnostic at %d:%d, want %d:%d: %v", first.Line, first.Column, test.line, test.column, result.Diagnostics)
+			}
+			for _, name := range test.methods {
+				findMethod(t, result.Methods, name)
+			}
+		})
+	}
+}
+
+func TestParseFileAbandoned(t *testing.T) {
+	limits := parseLimits
+	defer func() { parseLimits = limits }()
+	parseLimits.timeout = time.Nanosecond
+	parseLimits.checkPeriod = 1
+
+	path := filepath.Join(t.TempDir(), "file.ts")
+	if err := os.WriteFile(path, []byte("function g(a: number) {\n  return a;\n}\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	result := typescriptComplexityParser{}.ParseFile(path, false)
+	if result.Status != complexCommons.ParseStatusAbandoned || !result.Failed() {
+		t.Fatalf("status = %s, want %s", result.Status, complexCommons.ParseStatusAbandoned)
+	}
+	if len(result.Methods) != 0 || len(result.Diagnostics) != 1 || result.Err == nil {
+		t.Errorf("result = %+v, want no methods and the reason it was abandoned", result)
+	}
+
+	// An abandoned parse is not cached, the next one may have more time
+	cache := filepath.Join(t.TempDir(), "cache")
+	analyzer := complexCommons.NewComplexityAnalyzer(CreateTypescriptComplexityParser().(complexCommons.FileParser), 1, cache)
+	analyzer.AnalyzeFiles([]string{path}, false)
+	parseLimits = limits
+	if again := analyzer.AnalyzeFiles([]string{path}, false); again[0].Cached || again[0].Result.Status != complexCommons.ParseStatusParsed {
+		t.Errorf("parsed again = %+v, want it parsed without the cache", again[0])
+	}
+}
@@ -0,0 +1,167 @@
+package typescript
+
+import (
+	"SubmissionGrader/internal/complexity/complexCommons"
+	parser "SubmissionGrader/internal/complexity/typescript/typeScriptAntlrParser"
+	"strings"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// EnterSourceElements
+// The statements at the top of the file and of function bodies
+func (l *typescriptComplexityListener) EnterSourceElements(ctx *parser.SourceElementsContext) {
+	var statements []parser.IStatementContext
+	for _, element := range ctx.AllSourceElement() {
+		if element, ok := element.(*parser.SourceElementContext); ok && element.Statement() != nil {
+			statements = append(statements, element.Statement())
+		}
+	}
+	l.findUnreachable(statements)
+}
+
+// EnterStatementList
+// The statements of blocks and of case clauses
+func (l *typescriptComplexityListener) EnterStatementList(ctx *parser.StatementListContext) {
+	l.findUnreachable(ctx.AllStatement())
+}
+
+// findUnreachable
+// Records the first statement following a return, throw, break or continue of the same list.
+// Function, interface and type declarations are skipped, since they are hoisted or are not code
+func (l *typescriptComplexityListener) findUnreachable(statements []parser.IStatementContext) {
+	after := ""
+	for _, statement := range statements {
+		statement, ok := statement.(*parser.StatementContext)
+		if !ok {
+			continue
+		}
+		if after == "" {
+			after = getJumpKind(statement)
+			continue
+		}
+		if statement.FunctionDeclaration() != nil || statement.GeneratorFunctionDeclaration() != nil ||
+			statement.InterfaceDeclaration() != nil || statement.TypeAliasDeclaration() != nil ||
+			statement.EmptyStatement_() != nil {
+			continue
+		}
+		l.unreachable = append(l.unreachable, complexCommons.UnreachableCode{Line: statement.GetStart().GetLine(), After: after})
+		return
+	}
+}
+
+// addDeclaration
+// Names starting with _ are unused on purpose
+func (l *typescriptComplexityListener) addDeclaration(name antlr.TerminalNode, kind string, exported bool) {
+	if name == nil || strings.HasPrefix(name.GetText(), "_") {
+		return
+	}
+	declaration := complexCommons.Declaration{
+		Name:     name.GetText(),
+		Kind:     kind,
+		Line:     name.GetSymbol().GetLine(),
+		Exported: exported,
+	}
+	if kind == complexCommons.DeclarationMethod {
+		declaration.Class = l.currentState.ClassName
+	}
+	l.declarations = append(l.declarations, declaration)
+}
+
+// addImportDeclarations
+// Adds the names bound by import { a, b } from 'module' and import * as name from 'module'
+func (l *typescriptComplexityListener) addImportDeclarations(ctx *parser.ImportStatementContext) {
+	if alias, ok := ctx.ImportAliasDeclaration().(*parser.ImportAliasDeclarationContext); ok {
+		l.addDeclaration(alias.Identifier(), complexCommons.DeclarationImport, false)
+	}
+	block, ok := ctx.FromBlock().(*parser.FromBlockContext)
+	if !ok {
+		return
+	}
+	names := []parser.IIdentifierNameContext{block.IdentifierName()}
+	if multiple, ok := block.MultipleImportStatement().(*parser.MultipleImportStatementContext); ok {
+		names = append(names, multiple.AllIdentifierName()...)
+	}
+	for _, name := range names {
+		if name, ok := name.(*parser.IdentifierNameContext); ok {
+			l.addDeclaration(name.Identifier(), complexCommons.DeclarationImport, false)
+		}
+	}
+}
+
+// isExported
+// Goes up through the statements around a declaration looking for an export
+func isExported(ctx antlr.Tree) bool {
+	for parent := ctx.GetParent(); parent != nil; parent = parent.GetParent() {
+		switch node := parent.(type) {
+		case *parser.ExportStatementContext:
+			return true
+		case *parser.SourceElementContext:
+			return node.Export() != nil
+		case *parser.StatementContext:
+			if node.Export() != nil {
+				return true
+			}
+		case *parser.VariableDeclarationListContext, *parser.VariableStatementContext:
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// hasOutsideCallers
+// Methods of classes that extend or implement something can be called through what they override,
+// and methods that are decorated, or in decorated classes, are called by their framework
+func hasOutsideCallers(ctx *parser.MethodDeclarationExpressionContext) bool {
+	if element, ok := ctx.GetParent().(*parser.ClassElementContext); ok && element.DecoratorList() != nil {
+		return true
+	}
+	for parent := ctx.GetParent(); parent != nil; parent = parent.GetParent() {
+		class, ok := parent.(*parser.ClassDeclarationContext)
+		if !ok {
+			continue
+		}
+		if class.DecoratorList() != nil {
+			return true
+		}
+		heritage, ok := class.ClassHeritage().(*parser.ClassHeritageContext)
+		if !ok {
+			return false
+		}
+		_, extends := heritage.ClassExtendsClause().(*parser.ClassExtendsClauseContext)
+		_, implements := heritage.ImplementsClause().(*parser.ImplementsClauseContext)
+		return extends || implements
+	}
+	return false
+}
+
+// getPropertyIdentifier
+// Gets the name of a method, or nil when it is named by a string or number
+func getPropertyIdentifier(propertyName parser.IPropertyNameContext) antlr.TerminalNode {
+	property, ok := propertyName.(*parser.PropertyNameContext)
+	if !ok {
+		return nil
+	}
+	name, ok := property.IdentifierName().(*parser.IdentifierNameContext)
+	if !ok {
+		return nil
+	}
+	return name.Identifier()
+}
+
+// getJumpKind
+// Gets which statement leaves the list, or an empty string if the statement does not
+func getJumpKind(statement *parser.StatementContext) string {
+	switch {
+	case statement.ReturnStatement() != nil:
+		return "return"
+	case statement.ThrowStatement() != nil:
+		return "throw"
+	case statement.BreakStatement() != nil:
+		return "break"
+	case statement.ContinueStatement() != nil:
+		return "continue"
+	}
+	return ""
+}
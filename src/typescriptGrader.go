@@ -2,24 +2,240 @@ package graderFactory
 
 import (
 	"SubmissionGrader/internal/common"
+	"SubmissionGrader/internal/complexity/complexCommons"
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
 	parserFactory "SubmissionGrader/internal/parser"
+	"SubmissionGrader/internal/parser/parserTypes"
+	"SubmissionGrader/internal/parser/parserTypes/list"
+	"context"
 	"fmt"
 	"os"
-	"os/exec"
 	"strconv"
 	"strings"
 	"time"
 )
 
+const (
+	jestCoberturaReport      = "/coverage/cobertura-coverage.xml"
+	jestSummaryReport        = "/coverage/" + parserTypes.JestSummaryFileName
+	typescriptCoverageReport = "/coverage.xml"
+	typescriptSummaryReport  = "/" + parserTypes.JestSummaryFileName
+	typescriptTimeoutReport  = "/TEST-jest-timeout.xml"
+)
+
+// How long installing the jest reporter may take, apart from the bound on the tests
+// since it only depends on the network
+const npmInstallTimeout = 5 * time.Minute
+
+// The parts of the grade worked out from the parsed submission rather than its tests
+const (
+	analysisComplexity = "complexity"
+	analysisImports    = "imports"
+	analysisDeadCode   = "dead code"
+)
+
 type typescriptGrader struct {
 	grader graderStruct
+
+	rubric             *Rubric // loaded from a checkout of the teacher tests, before either phase runs
+	rubricLoaded       bool
+	TeacherRubricScore RubricScore
+	StudentRubricScore RubricScore
+	ComplexityScore    ComplexityScore
+	DuplicationScore   DuplicationScore
+	ImportScore        ImportScore
+
+	sandbox           *sandboxRunner
+	SandboxViolations []SandboxResult
+
+	files               []complexCommons.FileParseResult // every file of the submission, parsed once for everything below
+	parseErr            error                            // why the submission could not be parsed, so it is not tried again
+	UnavailableAnalyses []string                         // the parts left out of the grade because the submission could not be parsed
+	ComplexityReport    ComplexityReport
+	DependencyGraph     complexCommons.DependencyGraph
+	CallGraph           complexCommons.CallGraph
+	DeadCode            complexCommons.DeadCodeReport
 }
 
 func (t typescriptGrader) GetGrader() graderStruct {
 	return t.grader
 }
 
+// GetRubricScores
+// Returns the rubric scores of the teacher tests and the student tests,
+// with the deductions of the complexity limits, duplication limit and import policy taken off of both
+func (t typescriptGrader) GetRubricScores() (RubricScore, RubricScore) {
+	return t.TeacherRubricScore.WithComplexity(t.ComplexityScore).WithDuplication(t.DuplicationScore).WithImports(t.ImportScore),
+		t.StudentRubricScore.WithComplexity(t.ComplexityScore).WithDuplication(t.DuplicationScore).WithImports(t.ImportScore)
+}
+
+// ApplyComplexityLimits
+// Checks the methods of the submission against the complexity limits of the rubric
+func (t *typescriptGrader) ApplyComplexityLimits(methods []methodInfoType.MethodInfo) {
+	t.loadRubric()
+	if t.rubric == nil {
+		return
+	}
+
+	t.ComplexityScore = t.rubric.ScoreComplexity(methods)
+	common.Debug(fmt.Sprintf("%d complexity limit violations, deducting %.2f", len(t.ComplexityScore.Violations), t.ComplexityScore.Deduction))
+}
+
+// ApplyDuplicationLimit
+// Checks the clones found in the submission against the duplication limit of the rubric
+func (t *typescriptGrader) ApplyDuplicationLimit(report complexCommons.CloneReport) {
+	t.loadRubric()
+	if t.rubric == nil {
+		return
+	}
+
+	t.DuplicationScore = t.rubric.ScoreDuplication(report)
+	common.Debug(fmt.Sprintf("%.2f%% of the submission is duplicated in %d clone groups, deducting %.2f",
+		t.DuplicationScore.Percentage, len(t.DuplicationScore.Groups), t.DuplicationScore.Deduction))
+}
+
+// GetSandboxViolations
+// Returns every command that was stopped for breaking a sandbox rule
+func (t typescriptGrader) GetSandboxViolations() []SandboxResult {
+	return t.SandboxViolations
+}
+
+// GetImportViolations
+// Returns every import that broke the import policy of the rubric
+func (t typescriptGrader) GetImportViolations() []ImportViolation {
+	return t.ImportScore.Violations
+}
+
+// GetDeadCode
+// Returns the unused declarations and unreachable statements of the submission
+func (t typescriptGrader) GetDeadCode() complexCommons.DeadCodeReport {
+	return t.DeadCode
+}
+
+// GetUnavailableAnalyses
+// Returns the parts of the grade that were left out because the submission could not be parsed
+func (t typescriptGrader) GetUnavailableAnalyses() []string {
+	return t.UnavailableAnalyses
+}
+
+// FindDeadCode
+// Finds the declarations nothing in the submission uses and the statements that can
+// never run, and writes them next to the submission as JSON
+func (t *typescriptGrader) FindDeadCode() error {
+	files, err := t.parseSubmission()
+	if err != nil {
+		return err
+	}
+	t.DeadCode = FindTypescriptDeadCode(files)
+	logDeadCode(t.DeadCode)
+	err = writeDeadCodeReport(t.grader.GetLocation(), t.DeadCode)
+	if err != nil {
+		common.Warning(fmt.Sprintf("Failed to write the dead code report: %s", err))
+	}
+	return nil
+}
+
+// BuildCallGraph
+// Builds the call graph of the submission, marking its recursive functions,
+// and writes it next to the submission as JSON and DOT
+func (t *typescriptGrader) BuildCallGraph() error {
+	files, err := t.parseSubmission()
+	if err != nil {
+		return err
+	}
+	t.CallGraph = BuildTypescriptCallGraph(files)
+	logRecursiveFunctions(t.CallGraph)
+	err = writeCallGraph(t.grader.GetLocation(), t.CallGraph)
+	if err != nil {
+		common.Warning(fmt.Sprintf("Failed to write the call graph: %s", err))
+	}
+	return nil
+}
+
+// CheckImportPolicy
+// Builds the dependency graph of the submission, writes it next to the submission as JSON
+// and DOT, and checks it against the import policy of the rubric
+func (t *typescriptGrader) CheckImportPolicy() error {
+	files, err := t.parseSubmission()
+	if err != nil {
+		return err
+	}
+	t.DependencyGraph = buildTypescriptDependencyGraph(files)
+	err = writeDependencyGraph(t.grader.GetLocation(), t.DependencyGraph)
+	if err != nil {
+		common.Warning(fmt.Sprintf("Failed to write the dependency graph: %s", err))
+	}
+
+	t.loadRubric()
+	if t.rubric == nil {
+		return nil
+	}
+	t.ImportScore = t.rubric.ScoreImports(t.DependencyGraph)
+	if logImportViolations(t.ImportScore.Violations) {
+		common.Warning(fmt.Sprintf("%d import policy violations found before running tests, deducting %.2f", len(t.ImportScore.Violations), t.ImportScore.Deduction))
+	}
+	return nil
+}
+
+// parseSubmission
+// Parses every file of the submission the first time it is needed. The checks before the tests
+// and the complexity report are all built from this, so no file is parsed more than once
+func (t *typescriptGrader) parseSubmission() ([]complexCommons.FileParseResult, error) {
+	if t.files != nil || t.parseErr != nil {
+		return t.files, t.parseErr
+	}
+	files, err := ParseTypescriptSubmission(t.grader.data.assignmentRootPath)
+	if err != nil {
+		common.Error(fmt.Sprintf("Failed to parse the submission: %s", err))
+		t.parseErr = err
+		return nil, err
+	}
+	t.files = files
+	return files, nil
+}
+
+// analyzeSubmission
+// Checks the imports of the submission and looks for its recursion and dead code once, before the
+// tests of either phase run. The call graph and dead code are only reported, so grading goes on without them.
+// A submission that cannot be parsed still has its tests graded, with the parts worked out from the parse marked as unavailable
+func (t *typescriptGrader) analyzeSubmission() {
+	common.Debug(fmt.Sprintf("Checking imports against the import policy"))
+	err := t.CheckImportPolicy()
+	if err != nil {
+		common.Error(fmt.Sprintf("Failed to analyze the submission, grading its tests without its complexity, imports and dead code: %s", err))
+		t.UnavailableAnalyses = []string{analysisComplexity, analysisImports, analysisDeadCode}
+		return
+	}
+
+	common.Debug(fmt.Sprintf("Building the call graph"))
+	t.BuildCallGraph()
+
+	common.Debug(fmt.Sprintf("Looking for dead code"))
+	t.FindDeadCode()
+}
+
+// loadRubric
+// Loads the rubric kept with the teacher tests, unless it already was.
+// This happens before the student phase, so both phases are scored with the same rubric
+func (t *typescriptGrader) loadRubric() {
+	if t.rubricLoaded {
+		return
+	}
+	t.rubricLoaded = true
+	rubric, err := loadTeacherRubric(t.grader.GetLocation()+"/src/test/typescript/teacher", func(directory string) error {
+		return t.grader.GetTemplateSubDirectory(t.grader, directory, "src/test/typescript/teacher", rubricPlacementName)
+	})
+	if err != nil {
+		common.Error(fmt.Sprintf("Failed to load rubric: %s", err))
+		return
+	}
+	t.rubric = rubric
+}
+
 func (t *typescriptGrader) GradeAssignment(grader graderStruct) error {
+	t.loadRubric()
+	t.analyzeSubmission()
+
 	common.Info(fmt.Sprintf("Grading students test cases"))
 
 	if grader.data.studentTestsEnabled == "true" {
@@ -40,8 +256,6 @@ func (t *typescriptGrader) GradeAssignment(grader graderStruct) error {
 		t.grader.data.GradingStudentTestCurrently = false
 	}
 
-	var err error
-
 	if grader.data.teacherUnitTestsEnabled == "true" {
 		if grader.data.studentTestsEnabled == "true" {
 			common.Debug(fmt.Sprintf("Removing Results from student tests"))
@@ -58,11 +272,12 @@ func (t *typescriptGrader) GradeAssignment(grader graderStruct) error {
 		}
 
 		common.Info(fmt.Sprintf("Getting teacher tests"))
-		err = grader.GetTemplateSubDirectory(grader, grader.GetLocation()+"/src/test/typescript", subdirectoryToGet, subdirectoryPlacementName) //Comment out when GRADE THINGS
+		err := grader.GetTemplateSubDirectory(grader, grader.GetLocation()+"/src/test/typescript", subdirectoryToGet, subdirectoryPlacementName) //Comment out when GRADE THINGS
 
 		if err != nil {
 			return err
 		}
+
 		common.Info(fmt.Sprintf("Grading teacher test cases"))
 		err = t.gradeSteps()
 		if err != nil {
@@ -96,6 +311,22 @@ func (t *typescriptGrader) gradeSteps() error {
 	return nil
 }
 
+// scoreWithRubric
+// Scores the results that were just gathered with the rubric loaded from the teacher tests
+func (t *typescriptGrader) scoreWithRubric(tests list.TestsLinkedList) {
+	if t.rubric == nil {
+		return
+	}
+
+	score := t.rubric.Score(tests)
+	if t.grader.data.GradingStudentTestCurrently {
+		t.StudentRubricScore = score
+	} else {
+		t.TeacherRubricScore = score
+	}
+	common.Debug(fmt.Sprintf("Rubric score: %.2f / %.2f", score.Total, score.Possible))
+}
+
 func (t *typescriptGrader) GetUnitTestReport(grader graderStruct) error {
 	repoName := grader.GetLocation() + grader.data.submissionTestPath
 	common.Debug(fmt.Sprintf("Getting parser factory object"))
@@ -124,6 +355,9 @@ func (t *typescriptGrader) GetUnitTestReport(grader graderStruct) error {
 		common.Debug(fmt.Sprintf("Teacher Test Results Gathered"))
 		t.grader.data.TeacherTestResults = parser.UnitTestResultsAndCoverage
 	}
+
+	common.Debug(fmt.Sprintf("Scoring results with rubric"))
+	t.scoreWithRubric(parser.UnitTestResultsAndCoverage.UnitTestResults)
 	return err
 }
 
@@ -143,54 +377,97 @@ func (t *typescriptGrader) GradeTests(grader graderStruct) error {
 		convertToSeconds = 1
 	}
 	timeoutSeconds := fmt.Sprintf("--testTimeout=%d", convertToSeconds)
-	cmd := exec.Command("npm", "i", "jest-junit")
-	cmd.Dir = grader.data.assignmentRootPath
-	err = cmd.Run()
-
-	//cmd = exec.Command("npm", "test")
-	cmd = exec.Command("npm", "test", "--verbose", timeoutSeconds, "--collectCoverage")
-	cmd.Dir = grader.data.assignmentRootPath
 
 	// Kills command if taking too long
 	timeoutMillisecondsBound := t.grader.data.maxTestingTimeUpperBound
 	convertToMillisecondsBound, _ := strconv.Atoi(timeoutMillisecondsBound)
-	go func() {
-		<-time.After(time.Millisecond * time.Duration(convertToMillisecondsBound))
-		_ = cmd.Process.Kill()
-	}()
+	timeoutBound := time.Millisecond * time.Duration(convertToMillisecondsBound)
+
+	rootPath := grader.data.assignmentRootPath
+	readOnlyPaths := []string{rootPath + "/src/test/typescript/teacher"}
 
-	err = cmd.Run()
+	// Installing needs the network, the tests themselves do not get it.
+	// The install scripts of the packages would run with the network, so they are skipped
+	installContext, cancelInstall := context.WithTimeout(context.Background(), npmInstallTimeout)
+	result := t.sandbox.Run(installContext, rootPath, readOnlyPaths, true, "npm", "i", "--ignore-scripts", "jest-junit")
+	cancelInstall()
+	t.recordSandboxResult(result)
+
+	testContext, cancelTest := context.WithTimeout(context.Background(), timeoutBound)
+	defer cancelTest()
+	result = t.sandbox.Run(testContext, rootPath, readOnlyPaths, false, "npm", "test", "--", "--verbose", timeoutSeconds, "--coverage", "--coverageReporters=cobertura", "--coverageReporters=json-summary")
+	t.recordSandboxResult(result)
+	err = result.Err
 
 	if err != nil {
-		if strings.Contains(err.Error(), "signal: killed") {
+		if result.Outcome == SandboxOutcomeTimeout {
 			t.grader.data.exceededUpperBound = true
-			dir := grader.data.repoPath + grader.data.repoName + grader.data.submissionTestPath
-			<-time.After(time.Millisecond * 100) // If you don't give it some breathing time, the directory does not get deleted which can lead to problems
-			common.MakeDir(dir)
-			message := fmt.Sprintln("pytest execution ran for too long of a period. \nCould possibly mean an infinite loop exists in the code.\nCould also mean not enough time was given for process to finish\nWe can't solve the halting problem")
-			dataToWrite := fmt.Sprintf("<?xml version=\"1.0\" encoding=\"utf-8\"?><testsuites><testsuite name=\"pytest\" errors=\"0\" failures=\"1\" skipped=\"0\" tests=\"1\" time=\"0.042\" timestamp=\"2023-01-29T11:23:36.249123\" hostname=\"Laptop.lan\"><testcase classname=\"CSGRADER_TOOK_TOO_LONG_ERROR\" name=\"CANNOT PROCESS THIS PROJECT\" time=\"0.001\"><failure message=\"%s\">self = &lt;test_with_unittest.TryTesting testMethod=CSGRADER_TOOK_TOO_LONG_ERROR04&gt;\n\n    CSGRADER_TOOK_TOO_LONG_ERROR_CSGRADER_TOOK_TOO_LONG_ERROR\n\nsrc/test_with_unittest.py:8: AssertionError</failure></testcase></testsuite></testsuites>", message)
-			const permission = 0777
-			errWrite := os.WriteFile(dir+"/TEST-result.xml", []byte(dataToWrite), permission)
-			if errWrite != nil {
-				return errWrite
-			}
+			t.grader.data.FailedToGetCoverage = true
+			return t.writeTimeoutReport(grader, result, convertToMillisecondsBound)
 		}
-		if err.Error() != "exit status 1" { // this just means the test failed
+		if result.Outcome != SandboxOutcomeFailed || result.ExitCode != 1 { // exit status 1 just means the test failed
 			return err
 		}
 	}
 
-	cmd = exec.Command("coverage", "xml")
-	cmd.Dir = grader.data.assignmentRootPath
-	err = cmd.Run()
-
+	err = copyJestCoverageReport(rootPath)
 	if err != nil {
+		common.Warning(fmt.Sprintf("Failed to get coverage report from jest: %s", err))
+		t.grader.data.FailedToGetCoverage = true
 		return err
 	}
 
 	return err
 }
 
+// writeTimeoutReport
+// jest-junit only writes its report once every test has ran, so when jest is killed
+// the tests that finished are taken from the verbose output and written with a TIMEOUT for the rest
+func (t *typescriptGrader) writeTimeoutReport(grader graderStruct, result SandboxResult, timeoutMilliseconds int) error {
+	dir := grader.GetLocation() + grader.data.submissionTestPath
+	common.MakeDir(dir)
+
+	finished := parserTypes.ParseJestVerboseOutput(result.Output)
+	common.Warning(fmt.Sprintf("Jest ran for longer than %d ms, %d tests finished before it was stopped", timeoutMilliseconds, len(finished)))
+
+	message := fmt.Sprintf("Jest ran for longer than %d ms and was stopped. This could mean an infinite loop exists in the code, or that not enough time was given for the tests to finish", timeoutMilliseconds)
+	errWrite := parserTypes.WriteTimeoutReport(dir+typescriptTimeoutReport, "jest", finished, message)
+	if errWrite != nil {
+		return errWrite
+	}
+	return result.Err
+}
+
+// recordSandboxResult
+// Keeps track of the commands that broke a sandbox rule so they can be reported
+func (t *typescriptGrader) recordSandboxResult(result SandboxResult) {
+	if logSandboxViolation(result) {
+		t.SandboxViolations = append(t.SandboxViolations, result)
+	}
+}
+
+// copyJestCoverageReport
+// Jest writes its cobertura and json-summary reports into the coverage directory,
+// this moves them to where the parser looks for the coverage report.
+// The summary has the function totals and is read instead of the cobertura report when it is there,
+// so the one from an earlier run is removed first
+func copyJestCoverageReport(assignmentRootPath string) error {
+	const permission = 0777
+	os.Remove(assignmentRootPath + typescriptSummaryReport)
+	summary, err := os.ReadFile(assignmentRootPath + jestSummaryReport)
+	if err != nil {
+		common.Warning(fmt.Sprintf("Failed to get the coverage summary from jest, functions are counted from the cobertura report: %s", err))
+	} else if err = os.WriteFile(assignmentRootPath+typescriptSummaryReport, summary, permission); err != nil {
+		common.Warning(fmt.Sprintf("Failed to copy the coverage summary from jest: %s", err))
+	}
+
+	content, err := os.ReadFile(assignmentRootPath + jestCoberturaReport)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(assignmentRootPath+typescriptCoverageReport, content, permission)
+}
+
 func (t typescriptGrader) NonCodeSubmissionEnabled(grader graderStruct) bool {
 	return grader.nonCodeSubmissionEnabled(grader)
 }
@@ -217,6 +494,26 @@ func (t typescriptGrader) PullAssignment(grader graderStruct) error {
 
 func (t *typescriptGrader) GetComplexity() {
 	t.grader.getComplexity()
+	t.AnalyzeComplexity()
+}
+
+// AnalyzeComplexity
+// Rolls the complexity of the submission up per file and per class, writes it next to
+// the submission as JSON and checks its methods against the complexity limits of the rubric
+func (t *typescriptGrader) AnalyzeComplexity() error {
+	files, err := t.parseSubmission()
+	if err != nil {
+		return err
+	}
+	t.ComplexityReport = BuildTypescriptComplexityReport(files)
+	logComplexityReport(t.ComplexityReport)
+	t.ApplyComplexityLimits(t.ComplexityReport.GetMethods())
+	t.ApplyDuplicationLimit(t.ComplexityReport.Clones)
+	err = writeComplexityReport(t.grader.GetLocation(), t.ComplexityReport)
+	if err != nil {
+		common.Warning(fmt.Sprintf("Failed to write the complexity report: %s", err))
+	}
+	return nil
 }
 
 func (t *typescriptGrader) PullRepoAndCheckCommits() bool {
@@ -236,5 +533,6 @@ func newTypescriptGrader() IGrader {
 		grader: graderStruct{
 			data: getGraderData("/tmp/typescript/", "/reports/"),
 		},
+		sandbox: newSandboxRunner(),
 	}
 }
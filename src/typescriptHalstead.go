@@ -0,0 +1,80 @@
+package typescript
+
+import (
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+	parser "SubmissionGrader/internal/complexity/typescript/typeScriptAntlrParser"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// halsteadCounter
+// Counts the operators and operands of one method as its tokens are visited
+type halsteadCounter struct {
+	operators map[string]int
+	operands  map[string]int
+}
+
+func newHalsteadCounter() *halsteadCounter {
+	return &halsteadCounter{
+		operators: map[string]int{},
+		operands:  map[string]int{},
+	}
+}
+
+// addToken
+// Names and literals are operands, everything else (keywords, punctuation and operators) is an operator.
+// Closing brackets are skipped so a pair such as ( ) is only counted once
+func (h *halsteadCounter) addToken(node antlr.TerminalNode) {
+	switch node.GetSymbol().GetTokenType() {
+	case antlr.TokenEOF,
+		parser.TypeScriptLexerCloseParen,
+		parser.TypeScriptLexerCloseBracket,
+		parser.TypeScriptLexerCloseBrace,
+		parser.TypeScriptLexerTemplateCloseBrace:
+		return
+	}
+
+	text := node.GetText()
+	if isHalsteadOperand(node) {
+		h.operands[text]++
+	} else {
+		h.operators[text]++
+	}
+}
+
+func (h *halsteadCounter) metrics() methodInfoType.HalsteadMetrics {
+	return methodInfoType.NewHalsteadMetrics(h.operators, h.operands)
+}
+
+// isHalsteadOperand
+// Keywords used as names (such as a property called get) are operands too
+func isHalsteadOperand(node antlr.TerminalNode) bool {
+	switch node.GetSymbol().GetTokenType() {
+	case parser.TypeScriptLexerIdentifier,
+		parser.TypeScriptLexerStringLiteral,
+		parser.TypeScriptLexerTemplateStringAtom,
+		parser.TypeScriptLexerRegularExpressionLiteral,
+		parser.TypeScriptLexerNullLiteral,
+		parser.TypeScriptLexerBooleanLiteral,
+		parser.TypeScriptLexerDecimalLiteral,
+		parser.TypeScriptLexerHexIntegerLiteral,
+		parser.TypeScriptLexerOctalIntegerLiteral,
+		parser.TypeScriptLexerOctalIntegerLiteral2,
+		parser.TypeScriptLexerBinaryIntegerLiteral,
+		parser.TypeScriptLexerThis,
+		parser.TypeScriptLexerSuper:
+		return true
+	}
+
+	parent := node.GetParent()
+	for {
+		switch parent.(type) {
+		case *parser.KeywordContext, *parser.ReservedWordContext:
+			parent = parent.GetParent()
+			continue
+		case *parser.IdentifierNameContext, *parser.IdentifierOrKeyWordContext:
+			return true
+		}
+		return false
+	}
+}
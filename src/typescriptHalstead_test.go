@@ -0,0 +1,56 @@
+package typescript
+
+import (
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+	"math"
+	"testing"
+)
+
+func TestHalsteadCountsOfMethods(t *testing.T) {
+	source := `function area(w: number, h: number): number {
+  const a = w * h;
+  return a;
+}
+
+function max(x: number, y: number) {
+  if (x > y) {
+    return x;
+  }
+  return y;
+}
+`
+	tests := []struct {
+		name string
+		want methodInfoType.HalsteadMetrics
+	}{
+		{
+			// operators: function ( : : : number number number , { const = * ; ; return
+			// operands:  area w h a w h a
+			name: "area",
+			want: methodInfoType.HalsteadMetrics{DistinctOperators: 11, DistinctOperands: 4, TotalOperators: 16, TotalOperands: 7},
+		},
+		{
+			// operators: function ( ( : : number number , { { if > return return ; ;
+			// operands:  max x y x y x y
+			name: "max",
+			want: methodInfoType.HalsteadMetrics{DistinctOperators: 10, DistinctOperands: 3, TotalOperators: 16, TotalOperands: 7},
+		},
+	}
+
+	methods := parseSource(t, "shapes.ts", source).Methods
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := findMethod(t, methods, test.name).Halstead
+			if got.DistinctOperators != test.want.DistinctOperators || got.DistinctOperands != test.want.DistinctOperands ||
+				got.TotalOperators != test.want.TotalOperators || got.TotalOperands != test.want.TotalOperands {
+				t.Errorf("n1, n2, N1, N2 = %d, %d, %d, %d, want %d, %d, %d, %d",
+					got.DistinctOperators, got.DistinctOperands, got.TotalOperators, got.TotalOperands,
+					test.want.DistinctOperators, test.want.DistinctOperands, test.want.TotalOperators, test.want.TotalOperands)
+			}
+			vocabulary := float64(test.want.DistinctOperators + test.want.DistinctOperands)
+			if volume := float64(test.want.TotalOperators+test.want.TotalOperands) * math.Log2(vocabulary); math.Abs(got.Volume-volume) > 1e-9 {
+				t.Errorf("volume = %f, want %f", got.Volume, volume)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package typescript
+
+import (
+	"strings"
+	"unicode"
+)
+
+// The generated lexer has no JSX mode, so .tsx and .jsx files have their JSX rewritten into plain
+// expressions before lexing. As with rewriteModernSyntax every character keeps its place.
+// An element becomes an array literal holding the expressions found inside of it, and
+// everything else (tags, attribute names, strings and text) becomes spaces:
+//
+//	<Item key={id} onClick={() => pick(id)}>{done && <Check/>}</Item>
+//	[          id,          () => pick(id),  done && [     0],     0]
+//
+// Which keeps the handlers as lambdas and conditional rendering (&& and ternaries)
+// as expressions, so they are counted like any other code.
+type jsxRewriter struct {
+	runes    []rune
+	tagNames map[string]int
+}
+
+// rewriteJsx
+// Rewrites every JSX element found in the source. Since tag names are blanked out,
+// it also returns how many times each was used, so components still count as used
+func rewriteJsx(source string) (string, map[string]int) {
+	rewriter := jsxRewriter{runes: []rune(source), tagNames: map[string]int{}}
+	rewriter.scanCode(0, false)
+	return string(rewriter.runes), rewriter.tagNames
+}
+
+// scanCode
+// Goes through code looking for JSX, skipping comments, strings and regular expressions.
+// When untilCloseBrace is set, it stops at the } closing the expression it started in and returns its index
+func (r *jsxRewriter) scanCode(i int, untilCloseBrace bool) int {
+	depth := 0
+	for i < len(r.runes) {
+		current := r.runes[i]
+		next := runeAt(r.runes, i+1)
+		switch {
+		case current == '/' && next == '/':
+			for i < len(r.runes) && r.runes[i] != '\n' {
+				i++
+			}
+			continue
+		case current == '/' && next == '*':
+			i += 2
+			for i < len(r.runes) && !(r.runes[i] == '*' && runeAt(r.runes, i+1) == '/') {
+				i++
+			}
+			i += 2
+			continue
+		case current == '\'' || current == '"':
+			i = r.skipString(i)
+			continue
+		case current == '`':
+			i = r.skipTemplate(i)
+			continue
+		case current == '/' && isRegexStart(r.runes, i):
+			i = r.skipRegex(i)
+			continue
+		case current == '{':
+			depth++
+		case current == '}':
+			if depth == 0 && untilCloseBrace {
+				return i
+			}
+			depth--
+		case current == '<' && r.isJsxStart(i):
+			i = r.rewriteElement(i, true)
+			continue
+		}
+		i++
+	}
+	return len(r.runes)
+}
+
+// isJsxStart
+// A < starts JSX when it is where an expression is expected and is followed by a tag name or >.
+// Generic arrow functions (<T,>(x: T) => x and <T extends U>) are left alone
+func (r *jsxRewriter) isJsxStart(i int) bool {
+	next := runeAt(r.runes, i+1)
+	if next != '>' && !isIdentifierStart(next) {
+		return false
+	}
+
+	previousIndex := i - 1
+	for previousIndex >= 0 && unicode.IsSpace(r.runes[previousIndex]) {
+		previousIndex--
+	}
+	previous := runeAt(r.runes, previousIndex)
+	switch {
+	case previousIndex < 0:
+	case previous == '>' && runeAt(r.runes, previousIndex-1) == '=': // =>
+	case isIdentifierPart(previous):
+		wordStart := previousIndex
+		for wordStart > 0 && isIdentifierPart(r.runes[wordStart-1]) {
+			wordStart--
+		}
+		word := string(r.runes[wordStart : previousIndex+1])
+		if word != "return" && word != "yield" && word != "default" {
+			return false
+		}
+	case !strings.ContainsRune("(=,:?&|!{[;", previous):
+		return false
+	}
+
+	if next == '>' {
+		return true
+	}
+	nameEnd := r.skipTagName(i + 1)
+	following := nextNonSpace(r.runes, nameEnd)
+	if following == ',' {
+		return false
+	}
+	afterSpaces := nameEnd
+	for afterSpaces < len(r.runes) && unicode.IsSpace(r.runes[afterSpaces]) {
+		afterSpaces++
+	}
+	return !(afterSpaces > nameEnd && r.hasWordAt(afterSpaces, "extends"))
+}
+
+// rewriteElement
+// Rewrites the element starting at i and returns the index just after it.
+// Only the outermost element of an expression becomes the brackets of the array,
+// nested elements end with a comma so their expressions join the same array
+func (r *jsxRewriter) rewriteElement(open int, outermost bool) int {
+	i := open + 1
+	blankFrom := open
+
+	// Tag and attributes
+	selfClosing := false
+	closed := false
+	nameEnd := r.skipTagName(i)
+	r.addTagName(i, nameEnd)
+	i = nameEnd
+	for i < len(r.runes) {
+		current := r.runes[i]
+		switch {
+		case current == '/' && runeAt(r.runes, i+1) == '>':
+			selfClosing = true
+			closed = true
+			i += 2
+		case current == '>':
+			i++
+		case current == '{':
+			r.blank(blankFrom, i)
+			i = r.rewriteEmbeddedExpression(i)
+			blankFrom = i
+			continue
+		case current == '\'' || current == '"':
+			i = r.skipString(i)
+			continue
+		case current == '<':
+			r.blank(blankFrom, i)
+			i = r.rewriteElement(i, false)
+			blankFrom = i
+			continue
+		default:
+			i++
+			continue
+		}
+		break
+	}
+
+	// Children and the closing tag
+	if !selfClosing {
+		for i < len(r.runes) {
+			current := r.runes[i]
+			if current == '<' && runeAt(r.runes, i+1) == '/' {
+				for i < len(r.runes) && r.runes[i] != '>' {
+					i++
+				}
+				closed = i < len(r.runes)
+				i++
+				break
+			}
+			switch current {
+			case '{':
+				r.blank(blankFrom, i)
+				i = r.rewriteEmbeddedExpression(i)
+				blankFrom = i
+			case '<':
+				r.blank(blankFrom, i)
+				i = r.rewriteElement(i, false)
+				blankFrom = i
+			default:
+				i++
+			}
+		}
+	}
+
+	if i > len(r.runes) {
+		i = len(r.runes)
+	}
+	r.blank(blankFrom, i)
+	// The grammar does not allow holes in arrays, so every element adds a 0 to keep the commas apart.
+	// They go on the last two characters of the closing tag, skipping its line breaks
+	last := r.previousBlank(i, blankFrom)
+	beforeLast := r.previousBlank(last, blankFrom)
+	if !closed || beforeLast < 0 { // unfinished element, there is no room to rewrite it
+		return i
+	}
+	r.runes[beforeLast] = '0'
+	if outermost {
+		r.runes[open] = '['
+		r.runes[last] = ']'
+	} else {
+		r.runes[last] = ','
+	}
+	return i
+}
+
+// previousBlank
+// Gets the index of the last blanked character before index, not going below from.
+// Returns -1 if there is none
+func (r *jsxRewriter) previousBlank(index int, from int) int {
+	for i := index - 1; i >= from && i >= 0; i-- {
+		if r.runes[i] == ' ' {
+			return i
+		}
+	}
+	return -1
+}
+
+// rewriteEmbeddedExpression
+// { expression } becomes " expression," and the expression itself is scanned for more JSX.
+// An empty one, such as a JSX comment, gets no comma
+func (r *jsxRewriter) rewriteEmbeddedExpression(open int) int {
+	r.runes[open] = ' '
+	closing := r.scanCode(open+1, true)
+	if closing >= len(r.runes) {
+		return len(r.runes)
+	}
+	r.runes[closing] = ' '
+	if !r.isOnlyComments(open+1, closing) {
+		r.runes[closing] = ','
+	}
+	return closing + 1
+}
+
+func (r *jsxRewriter) isOnlyComments(from int, to int) bool {
+	for i := from; i < to; i++ {
+		switch {
+		case unicode.IsSpace(r.runes[i]):
+		case r.runes[i] == '/' && runeAt(r.runes, i+1) == '*':
+			for i < to && !(r.runes[i] == '*' && runeAt(r.runes, i+1) == '/') {
+				i++
+			}
+			i++
+		case r.runes[i] == '/' && runeAt(r.runes, i+1) == '/':
+			for i < to && r.runes[i] != '\n' {
+				i++
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (r *jsxRewriter) skipTagName(i int) int {
+	for i < len(r.runes) && (isIdentifierPart(r.runes[i]) || strings.ContainsRune(".:-", r.runes[i])) {
+		i++
+	}
+	return i
+}
+
+// addTagName
+// Only the part before any . counts, as in <Menu.Item>, since that is the name in scope
+func (r *jsxRewriter) addTagName(from int, to int) {
+	name := string(r.runes[from:to])
+	if index := strings.IndexAny(name, ".:-"); index >= 0 {
+		name = name[:index]
+	}
+	if name != "" {
+		r.tagNames[name]++
+	}
+}
+
+func (r *jsxRewriter) skipString(open int) int {
+	quote := r.runes[open]
+	i := open + 1
+	for i < len(r.runes) && r.runes[i] != quote && r.runes[i] != '\n' {
+		if r.runes[i] == '\\' {
+			i++
+		}
+		i++
+	}
+	return i + 1
+}
+
+// skipRegex
+// Skips a regular expression, where a / inside [ ] does not end it
+func (r *jsxRewriter) skipRegex(open int) int {
+	inClass := false
+	i := open + 1
+	for i < len(r.runes) && r.runes[i] != '\n' {
+		switch r.runes[i] {
+		case '\\':
+			i++
+		case '[':
+			inClass = true
+		case ']':
+			inClass = false
+		case '/':
+			if !inClass {
+				return i + 1
+			}
+		}
+		i++
+	}
+	return i
+}
+
+func (r *jsxRewriter) skipTemplate(open int) int {
+	i := open + 1
+	for i < len(r.runes) && r.runes[i] != '`' {
+		switch {
+		case r.runes[i] == '\\':
+			i++
+		case r.runes[i] == '$' && runeAt(r.runes, i+1) == '{':
+			i = r.scanCode(i+2, true)
+		}
+		i++
+	}
+	return i + 1
+}
+
+func (r *jsxRewriter) hasWordAt(i int, word string) bool {
+	end := i + len(word)
+	return end <= len(r.runes) && string(r.runes[i:end]) == word && !isIdentifierPart(runeAt(r.runes, end))
+}
+
+// blank
+// Turns everything between from and to into spaces, keeping the line breaks
+func (r *jsxRewriter) blank(from int, to int) {
+	for i := from; i < to && i < len(r.runes); i++ {
+		if r.runes[i] != '\n' && r.runes[i] != '\r' {
+			r.runes[i] = ' '
+		}
+	}
+}
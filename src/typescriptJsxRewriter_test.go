@@ -0,0 +1,170 @@
+package typescript
+
+import "testing"
+
+func TestRewriteJsx(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		want     string
+		tagNames map[string]int
+	}{
+		{
+			name:     "attributes and children",
+			source:   "const a = <Item key={id} onClick={() => pick(id)}>{done && <Check/>}</Item>;",
+			want:     "const a = [          id,          () => pick(id),  done && [     0],     0];",
+			tagNames: map[string]int{"Item": 1, "Check": 1},
+		},
+		{
+			name:     "text",
+			source:   "const a = <div>text</div>;",
+			want:     "const a = [            0];",
+			tagNames: map[string]int{"div": 1},
+		},
+		{
+			name:     "fragment",
+			source:   "const a = <></>;",
+			want:     "const a = [  0];",
+			tagNames: map[string]int{},
+		},
+		{
+			name:     "nested elements over several lines",
+			source:   "return (\n  <ul>\n    {items.map(item => <li key={item}>{item}</li>)}\n  </ul>\n);",
+			want:     "return (\n  [   \n     items.map(item => [        item,  item,   0]),\n     0]\n);",
+			tagNames: map[string]int{"ul": 1, "li": 1},
+		},
+		{
+			name:     "line breaks in the closing tag are kept",
+			source:   "const a = <div\n>x</div\n>;",
+			want:     "const a = [   \n      0\n];",
+			tagNames: map[string]int{"div": 1},
+		},
+		{
+			name:     "comment",
+			source:   "const a = <p>{/* note */}</p>;",
+			want:     "const a = [   /* note */   0];",
+			tagNames: map[string]int{"p": 1},
+		},
+		{
+			name:     "member tag name and a > in a string",
+			source:   `const a = <Menu.Item title="a > b" />;`,
+			want:     "const a = [                        0];",
+			tagNames: map[string]int{"Menu": 1},
+		},
+		{
+			name:     "template string",
+			source:   "const a = <p>{`${x}`}</p>;",
+			want:     "const a = [   `${x}`,  0];",
+			tagNames: map[string]int{"p": 1},
+		},
+		{
+			name:     "ternary",
+			source:   "const a = cond ? <A/> : <B></B>;",
+			want:     "const a = cond ? [ 0] : [    0];",
+			tagNames: map[string]int{"A": 1, "B": 1},
+		},
+		{
+			name:     "generic arrow function",
+			source:   "const id = <T,>(x: T) => x;",
+			want:     "const id = <T,>(x: T) => x;",
+			tagNames: map[string]int{},
+		},
+		{
+			name:     "regular expression",
+			source:   "const r = /<a>/.test(s) ? <b/> : null;",
+			want:     "const r = /<a>/.test(s) ? [ 0] : null;",
+			tagNames: map[string]int{"b": 1},
+		},
+		{
+			name:     "unfinished element",
+			source:   "const a = <div>",
+			want:     "const a =      ",
+			tagNames: map[string]int{"div": 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, tagNames := rewriteJsx(test.source)
+			if got != test.want {
+				t.Errorf("rewriteJsx(%q)\n got %q\nwant %q", test.source, got, test.want)
+			}
+			if len(tagNames) != len(test.tagNames) {
+				t.Errorf("tag names = %v, want %v", tagNames, test.tagNames)
+			}
+			for name, count := range test.tagNames {
+				if tagNames[name] != count {
+					t.Errorf("tag names = %v, want %v", tagNames, test.tagNames)
+				}
+			}
+		})
+	}
+}
+
+func TestComplexityOfTsx(t *testing.T) {
+	source := `import React, { useState } from 'react';
+
+type Todo = { id: number; text: string; done: boolean };
+
+export function TodoList({ todos, onToggle }: { todos: Todo[]; onToggle: (id: number) => void }) {
+  const [filter, setFilter] = useState<'all' | 'done'>('all');
+  const shown = todos.filter(todo => filter === 'all' || todo.done);
+  if (shown.length === 0) {
+    return <p className="empty">Nothing to do</p>;
+  }
+  return (
+    <div>
+      {/* the filter */}
+      <button onClick={() => setFilter(filter === 'all' ? 'done' : 'all')}>Toggle</button>
+      <ul>
+        {shown.map(todo => (
+          <li key={todo.id} onClick={() => onToggle(todo.id)}>
+            {todo.done ? <s>{todo.text}</s> : todo.text}
+            {todo.text.match(/<\w+>/) && <em>has a tag</em>}
+          </li>
+        ))}
+      </ul>
+    </div>
+  );
+}
+`
+	tests := []struct {
+		name string
+		cyc  int
+		cog  int
+	}{
+		{name: "TodoList", cyc: 2, cog: 2},
+		{name: "todos.filter callback", cyc: 2, cog: 2},
+		{name: "shown.map callback", cyc: 3, cog: 3},
+	}
+
+	methods := parseSource(t, "todoList.tsx", source).Methods
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			method := findMethod(t, methods, test.name)
+			if method.CycCount != test.cyc || method.CogCount != test.cog {
+				t.Errorf("counts = %d/%d, want %d/%d", method.CycCount, method.CogCount, test.cyc, test.cog)
+			}
+		})
+	}
+}
+
+func TestGenericArrowFunctionInTsx(t *testing.T) {
+	source := `const id = <T,>(x: T) => x;
+const pair = <K, V>(key: K, value: V): [K, V] => [key, value];
+`
+	methods := parseSource(t, "generic.tsx", source).Methods
+	findMethod(t, methods, "id")
+	findMethod(t, methods, "pair")
+}
+
+func TestComplexityOfJsx(t *testing.T) {
+	source := `export function Greeting({ name }) {
+  return <p>{name ? <b>{name}</b> : 'Hello'}</p>;
+}
+`
+	method := findMethod(t, parseSource(t, "greeting.jsx", source).Methods, "Greeting")
+	if method.CycCount != 2 || method.CogCount != 2 {
+		t.Errorf("counts = %d/%d, want 2/2", method.CycCount, method.CogCount)
+	}
+}
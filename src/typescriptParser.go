@@ -2,9 +2,14 @@ package parserTypes
 
 import (
 	"SubmissionGrader/internal/parser/parserTypes/list"
+	"encoding/json"
 	"encoding/xml"
+	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
+	"strings"
 )
 
 // PYTEST
@@ -26,20 +31,97 @@ type TypescriptCoverage struct {
 }
 
 type TypescriptCoverageTotal struct {
-	LinesValid      string `xml:"lines-valid,attr"`
-	LinesCovered    string `xml:"lines-covered,attr"`
-	BranchesValid   string `xml:"branches-valid,attr"`
-	BranchesCovered string `xml:"branches-covered,attr"`
+	LinesValid      string                      `xml:"lines-valid,attr"`
+	LinesCovered    string                      `xml:"lines-covered,attr"`
+	BranchesValid   string                      `xml:"branches-valid,attr"`
+	BranchesCovered string                      `xml:"branches-covered,attr"`
+	Packages        []TypescriptCoveragePackage `xml:"packages>package"`
+}
+
+type TypescriptCoveragePackage struct {
+	Name    string                    `xml:"name,attr"`
+	Classes []TypescriptCoverageClass `xml:"classes>class"`
+}
+
+type TypescriptCoverageClass struct {
+	Name     string                     `xml:"name,attr"`
+	FileName string                     `xml:"filename,attr"`
+	Methods  []TypescriptCoverageMethod `xml:"methods>method"`
+}
+
+type TypescriptCoverageMethod struct {
+	Name string `xml:"name,attr"`
+	Hits string `xml:"hits,attr"`
+}
+
+// The report of the json-summary reporter of jest, which the grader puts next to the cobertura report
+const JestSummaryFileName = "coverage-summary.json"
+
+// Totals of the json-summary reporter of jest, which counts functions itself
+type TypescriptCoverageSummary struct {
+	Total TypescriptCoverageSummaryTotal `json:"total"`
+}
+
+type TypescriptCoverageSummaryTotal struct {
+	Lines     TypescriptCoverageCount `json:"lines"`
+	Functions TypescriptCoverageCount `json:"functions"`
+	Branches  TypescriptCoverageCount `json:"branches"`
+}
+
+type TypescriptCoverageCount struct {
+	Total   int `json:"total"`
+	Covered int `json:"covered"`
 }
 
 // CoverageParser
-// parses the output from what is covered by testing in the assignment
+// parses the output from what is covered by testing in the assignment.
+// The json-summary report of jest is read when it is given or is next to the cobertura report,
+// otherwise the functions are counted from the methods of the cobertura report
 func (t typescriptParser) CoverageParser(location string) (CoverageResultsRawType, error) {
+	if strings.HasSuffix(location, ".json") {
+		return t.summaryCoverageParser(location)
+	}
+	summary := filepath.Join(filepath.Dir(location), JestSummaryFileName)
+	if _, err := os.Stat(summary); err == nil {
+		return t.summaryCoverageParser(summary)
+	}
+	return t.coberturaCoverageParser(location)
+}
+
+// Parses the totals of the json-summary reporter of jest
+func (t typescriptParser) summaryCoverageParser(location string) (CoverageResultsRawType, error) {
+	content, err := os.ReadFile(location)
+	if err != nil {
+		return CoverageResultsRawType{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, err
+	}
+	var summary TypescriptCoverageSummary
+	err = json.Unmarshal(content, &summary)
+	if err != nil {
+		return CoverageResultsRawType{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, err
+	}
+
+	total := summary.Total
+	return CoverageResultsRawType{
+		MissedLines:         total.Lines.Total - total.Lines.Covered,
+		CoveredLines:        total.Lines.Covered,
+		MissedFunctions:     total.Functions.Total - total.Functions.Covered,
+		CoveredFunctions:    total.Functions.Covered,
+		MissedBranches:      total.Branches.Total - total.Branches.Covered,
+		CoveredBranches:     total.Branches.Covered,
+		MissedInstructions:  -1,
+		CoveredInstructions: -1,
+		MissedComplexity:    -1,
+		CoveredComplexity:   -1,
+	}, nil
+}
+
+// Parses the report of the cobertura reporter of jest
+func (t typescriptParser) coberturaCoverageParser(location string) (CoverageResultsRawType, error) {
 	returnItem := CoverageResultsRawType{
 		MissedLines:         0,
 		CoveredLines:        0,
-		MissedFunctions:     -1,
-		CoveredFunctions:    -1,
+		MissedFunctions:     0,
+		CoveredFunctions:    0,
 		MissedBranches:      0,
 		CoveredBranches:     0,
 		MissedInstructions:  -1,
@@ -71,6 +153,20 @@ func (t typescriptParser) CoverageParser(location string) (CoverageResultsRawTyp
 		returnItem.MissedLines = MissedLines
 		returnItem.CoveredBranches = CoveredBranches
 		returnItem.MissedBranches = MissedBranches
+
+		// Cobertura has no function totals, so each method is checked for hits
+		for _, coveragePackage := range queriedObject.Packages {
+			for _, coverageClass := range coveragePackage.Classes {
+				for _, method := range coverageClass.Methods {
+					hits, _ := strconv.Atoi(method.Hits)
+					if hits > 0 {
+						returnItem.CoveredFunctions++
+					} else {
+						returnItem.MissedFunctions++
+					}
+				}
+			}
+		}
 	}
 
 	return returnItem, nil
@@ -105,23 +201,31 @@ type TypescriptSuites struct {
 }
 
 type TypescriptUnitTests struct {
-	Errors    string               `xml:"errors,attr"`
-	Failures  string               `xml:"failures,attr"`
-	Skipped   string               `xml:"skipped,attr"`
-	Tests     string               `xml:"tests,attr"`
-	TestCases []TypescriptTestCase `xml:"testcase"`
+	Name      string                 `xml:"name,attr"`
+	Errors    string                 `xml:"errors,attr"`
+	Failures  string                 `xml:"failures,attr"`
+	Skipped   string                 `xml:"skipped,attr"`
+	Tests     string                 `xml:"tests,attr"`
+	TestCases []TypescriptTestCase   `xml:"testcase"`
+	Error     *TypescriptErrorStatus `xml:"error"`
+	SystemErr string                 `xml:"system-err"`
 }
 
 type TypescriptTestCase struct {
-	ClassName string                  `xml:"classname,attr"`
-	Name      string                  `xml:"name,attr"`
-	Skip      TypescriptSkipStatus    `xml:"skipped"`
-	Error     TypescriptErrorStatus   `xml:"error"`
-	Fail      TypescriptFailureStatus `xml:"failure"`
+	ClassName string                   `xml:"classname,attr"`
+	Name      string                   `xml:"name,attr"`
+	Time      string                   `xml:"time,attr"`
+	Skip      *TypescriptSkipStatus    `xml:"skipped"`
+	Error     *TypescriptErrorStatus   `xml:"error"`
+	Fail      *TypescriptFailureStatus `xml:"failure"`
+	SystemOut string                   `xml:"system-out"`
+	SystemErr string                   `xml:"system-err"`
 }
 
+// The body of a failure or error is where the assertion diff and stack trace end up
 type TypescriptFailureStatus struct {
 	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
 }
 
 type TypescriptSkipStatus struct {
@@ -130,6 +234,8 @@ type TypescriptSkipStatus struct {
 
 type TypescriptErrorStatus struct {
 	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Body    string `xml:",chardata"`
 }
 
 func (s TypescriptSuites) ListFormat() list.TestsLinkedList {
@@ -142,6 +248,9 @@ func (s TypescriptSuites) ListFormat() list.TestsLinkedList {
 			thisTest := testCase.TestFormat()
 			returnList.AddTest(testCase.ClassName, thisTest)
 		}
+		if suiteError, hasError := testSuite.SuiteErrorFormat(); hasError {
+			returnList.AddTest(testSuite.Name, suiteError)
+		}
 	}
 
 	return returnList
@@ -153,26 +262,147 @@ func (s TypescriptTestCase) TestFormat() list.UnitTest {
 
 	passFailStatus := "PASSED"
 
-	failMessage := ""
-	failMessage = s.Fail.Message
-	skipMessage := ""
-	skipMessage = s.Skip.Message
-	errorMessage := ""
-	errorMessage = s.Error.Message
-
-	if failMessage != "" {
+	// The elements are checked instead of their messages since
+	// reporters like jest-junit leave the message attribute out
+	if s.Fail != nil {
 		passFailStatus = "FAILED"
-		thisTest.Message = failMessage
-	} else if skipMessage != "" {
+		thisTest.Message = getMessageOrFirstLine(s.Fail.Message, s.Fail.Body)
+		thisTest.StackTrace = strings.TrimSpace(s.Fail.Body)
+	} else if s.Skip != nil {
 		passFailStatus = "SKIPPED"
-	} else if errorMessage != "" {
+	} else if s.Error != nil {
 		passFailStatus = "ERRORED"
+		if s.Error.Type == TimeoutErrorType {
+			passFailStatus = OutcomeTimeout
+		}
+		thisTest.Message = getMessageOrFirstLine(s.Error.Message, s.Error.Body)
+		thisTest.StackTrace = strings.TrimSpace(s.Error.Body)
 	} else {
 		thisTest.Message = ""
 	}
 
 	thisTest.Outcome = passFailStatus
 	thisTest.Name = s.Name
+	thisTest.Duration, _ = strconv.ParseFloat(s.Time, 64)
+	thisTest.SystemOut = strings.TrimSpace(s.SystemOut)
+	thisTest.SystemErr = strings.TrimSpace(s.SystemErr)
 
 	return thisTest
 }
+
+// getMessageOrFirstLine
+// Uses the message attribute if there is one, otherwise the first line of the body
+func getMessageOrFirstLine(message string, body string) string {
+	if message != "" {
+		return message
+	}
+	body = strings.TrimSpace(body)
+	if index := strings.Index(body, "\n"); index != -1 {
+		return body[:index]
+	}
+	return body
+}
+
+// SuiteErrorFormat
+// When a test file fails to even run (such as not compiling), the suite reports
+// errors without a test case for each of them. Those errors become one ERRORED
+// test named after the suite so the submission is not shown as passing.
+// jest-junit counts a suite that failed to run under errors but can also write it as a test case
+// with a failure, which is then already reported, so failures count as reported errors too.
+// A suite that ran but has failing tests reports no errors
+func (s TypescriptUnitTests) SuiteErrorFormat() (list.UnitTest, bool) {
+	errorCount, _ := strconv.Atoi(s.Errors)
+	reportedErrors := 0
+	for _, testCase := range s.TestCases {
+		if testCase.Error != nil || testCase.Fail != nil {
+			reportedErrors++
+		}
+	}
+	if errorCount <= reportedErrors {
+		return list.UnitTest{}, false
+	}
+
+	thisTest := list.UnitTest{}
+	thisTest.Outcome = "ERRORED"
+	thisTest.Name = s.Name
+	if s.Error != nil {
+		thisTest.Message = getMessageOrFirstLine(s.Error.Message, s.Error.Body)
+		thisTest.StackTrace = strings.TrimSpace(s.Error.Body)
+	} else if strings.TrimSpace(s.SystemErr) != "" {
+		thisTest.Message = getMessageOrFirstLine("", s.SystemErr)
+		thisTest.StackTrace = strings.TrimSpace(s.SystemErr)
+	} else {
+		thisTest.Message = fmt.Sprintf("Test suite %s failed to run", s.Name)
+	}
+
+	return thisTest, true
+}
+
+var (
+	jestColorCode = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	jestDuration  = regexp.MustCompile(` \(\d+(\.\d+)? ?m?s\)$`)
+)
+
+// ParseJestVerboseOutput
+// Jest prints each test file's results with --verbose as soon as that file finishes,
+// which is the only record of the finished tests when jest is killed before writing its report.
+//
+//	PASS src/test/typescript/stack.test.ts
+//	  Stack
+//	    ✓ push (2 ms)
+//	    ✕ pop (1 ms)
+//
+// The names are built the same way as the default templates of jest-junit
+// (describe blocks and title separated by spaces) so they match the normal report
+func ParseJestVerboseOutput(output string) []TestOutcome {
+	var outcomes []TestOutcome
+	var describes []string
+	inFileResults := false
+
+	for _, line := range strings.Split(jestColorCode.ReplaceAllString(output, ""), "\n") {
+		line = strings.TrimRight(line, " \r")
+		text := strings.TrimLeft(line, " ")
+		if strings.HasPrefix(line, "PASS ") || strings.HasPrefix(line, "FAIL ") {
+			inFileResults = true
+			describes = nil
+			continue
+		}
+		// The failure details and the summary come after the results of a file
+		if !inFileResults || text == "" {
+			continue
+		}
+		if strings.HasPrefix(text, "●") || strings.HasPrefix(line, "Test Suites:") {
+			inFileResults = false
+			continue
+		}
+
+		depth := (len(line)-len(text))/2 - 1
+		if depth < 0 {
+			continue
+		}
+		if depth < len(describes) {
+			describes = describes[:depth]
+		}
+
+		outcome := ""
+		switch {
+		case strings.HasPrefix(text, "✓"), strings.HasPrefix(text, "√"):
+			outcome = "PASSED"
+		case strings.HasPrefix(text, "✕"), strings.HasPrefix(text, "×"):
+			outcome = "FAILED"
+		case strings.HasPrefix(text, "○"), strings.HasPrefix(text, "✎"):
+			outcome = "SKIPPED"
+		}
+		if outcome == "" {
+			describes = append(describes, text)
+			continue
+		}
+
+		_, title, _ := strings.Cut(text, " ")
+		title = strings.TrimPrefix(jestDuration.ReplaceAllString(title, ""), "skipped ")
+		title = strings.TrimPrefix(title, "todo ")
+		name := strings.Join(append(append([]string{}, describes...), title), " ")
+		outcomes = append(outcomes, TestOutcome{ClassName: name, Name: name, Outcome: outcome})
+	}
+	return outcomes
+}
@@ -0,0 +1,399 @@
+package parserTypes
+
+import (
+	"SubmissionGrader/internal/parser/parserTypes/list"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeFile
+// Writes the content into a file of the given name in a directory removed after the test
+func writeFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0666); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestTypescriptCoverageParser(t *testing.T) {
+	report := `<?xml version="1.0" ?>
+<coverage lines-valid="20" lines-covered="15" line-rate="0.75" branches-valid="8" branches-covered="6" branch-rate="0.75" timestamp="1" complexity="0" version="0.1">
+  <packages>
+    <package name="src" line-rate="0.75" branch-rate="0.75">
+      <classes>
+        <class name="stack.ts" filename="src/stack.ts" line-rate="0.75" branch-rate="0.75">
+          <methods>
+            <method name="(anonymous_0)" hits="3" signature="()V"/>
+            <method name="push" hits="5" signature="()V"/>
+            <method name="peek" hits="0" signature="()V"/>
+          </methods>
+        </class>
+        <class name="queue.ts" filename="src/queue.ts" line-rate="0" branch-rate="0">
+          <methods>
+            <method name="enqueue" hits="0" signature="()V"/>
+          </methods>
+        </class>
+      </classes>
+    </package>
+  </packages>
+</coverage>
+`
+	got, err := typescriptParser{}.CoverageParser(writeFile(t, "coverage.xml", report))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := CoverageResultsRawType{
+		MissedLines:         5,
+		CoveredLines:        15,
+		MissedFunctions:     2,
+		CoveredFunctions:    2,
+		MissedBranches:      2,
+		CoveredBranches:     6,
+		MissedInstructions:  -1,
+		CoveredInstructions: -1,
+		MissedComplexity:    -1,
+		CoveredComplexity:   -1,
+	}
+	if got != want {
+		t.Errorf("CoverageParser() = %+v, want %+v", got, want)
+	}
+}
+
+func TestTypescriptCoverageSummaryParser(t *testing.T) {
+	summary := `{"total": {
+  "lines": {"total": 20, "covered": 15, "skipped": 0, "pct": 75},
+  "statements": {"total": 22, "covered": 16, "skipped": 0, "pct": 72.72},
+  "functions": {"total": 6, "covered": 5, "skipped": 0, "pct": 83.33},
+  "branches": {"total": 8, "covered": 6, "skipped": 0, "pct": 75},
+  "branchesTrue": {"total": 0, "covered": 0, "skipped": 0, "pct": 100}
+},
+"/app/src/stack.ts": {"lines": {"total": 20, "covered": 15, "skipped": 0, "pct": 75}}}
+`
+	want := CoverageResultsRawType{
+		MissedLines:         5,
+		CoveredLines:        15,
+		MissedFunctions:     1,
+		CoveredFunctions:    5,
+		MissedBranches:      2,
+		CoveredBranches:     6,
+		MissedInstructions:  -1,
+		CoveredInstructions: -1,
+		MissedComplexity:    -1,
+		CoveredComplexity:   -1,
+	}
+
+	got, err := typescriptParser{}.CoverageParser(writeFile(t, JestSummaryFileName, summary))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("CoverageParser() = %+v, want %+v", got, want)
+	}
+
+	// The summary next to the cobertura report is read in its place
+	cobertura := filepath.Join(filepath.Dir(writeFile(t, JestSummaryFileName, summary)), "coverage.xml")
+	if err := os.WriteFile(cobertura, []byte(`<coverage lines-valid="1" lines-covered="1"></coverage>`), 0666); err != nil {
+		t.Fatal(err)
+	}
+	got, err = typescriptParser{}.CoverageParser(cobertura)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("CoverageParser() next to a summary = %+v, want %+v", got, want)
+	}
+}
+
+func TestTypescriptCoverageParserErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		location func(t *testing.T) string
+	}{
+		{name: "missing file", location: func(t *testing.T) string { return filepath.Join(t.TempDir(), "coverage.xml") }},
+		{name: "not xml", location: func(t *testing.T) string { return writeFile(t, "coverage.xml", "{}") }},
+		{name: "not json", location: func(t *testing.T) string { return writeFile(t, JestSummaryFileName, "<coverage/>") }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := (typescriptParser{}).CoverageParser(test.location(t)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+// listTests
+// The tests of the list in order, with the class each was reported under
+func listTests(tests list.TestsLinkedList) ([]string, []list.UnitTest) {
+	var classNames []string
+	var unitTests []list.UnitTest
+	for node := tests.Head; node != nil; node = node.Next {
+		classNames = append(classNames, node.ClassName)
+		unitTests = append(unitTests, node.Test)
+	}
+	return classNames, unitTests
+}
+
+func TestTypescriptFileParse(t *testing.T) {
+	report := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="jest tests" tests="5" failures="1" errors="0" time="1.2">
+  <testsuite name="Stack" errors="0" failures="1" skipped="1" timestamp="2024-01-01T00:00:00" time="1.1" tests="5">
+    <testcase classname="Stack push" name="Stack push" time="0.004">
+      <system-out>pushed 1</system-out>
+    </testcase>
+    <testcase classname="Stack pop" name="Stack pop" time="0.012">
+      <failure>Error: expect(received).toBe(expected)
+
+Expected: 2
+Received: 1
+    at Object.&lt;anonymous&gt; (src/test/typescript/stack.test.ts:12:24)</failure>
+    </testcase>
+    <testcase classname="Stack peek" name="Stack peek" time="0">
+      <skipped/>
+    </testcase>
+    <testcase classname="Stack size" name="Stack size" time="0.5">
+      <error message="TypeError: size is not a function" type="TypeError">TypeError: size is not a function
+    at Object.&lt;anonymous&gt; (src/test/typescript/stack.test.ts:20:11)</error>
+      <system-err>warning</system-err>
+    </testcase>
+    <testcase classname="Stack clear" name="Stack clear" time="0">
+      <error message="Jest ran for longer than 1000 ms" type="timeout"/>
+    </testcase>
+  </testsuite>
+</testsuites>
+`
+	want := []list.UnitTest{
+		{Name: "Stack push", Outcome: "PASSED", Duration: 0.004, SystemOut: "pushed 1"},
+		{Name: "Stack pop", Outcome: "FAILED", Message: "Error: expect(received).toBe(expected)", Duration: 0.012,
+			StackTrace: "Error: expect(received).toBe(expected)\n\nExpected: 2\nReceived: 1\n    at Object.<anonymous> (src/test/typescript/stack.test.ts:12:24)"},
+		{Name: "Stack peek", Outcome: "SKIPPED"},
+		{Name: "Stack size", Outcome: "ERRORED", Message: "TypeError: size is not a function", Duration: 0.5,
+			StackTrace: "TypeError: size is not a function\n    at Object.<anonymous> (src/test/typescript/stack.test.ts:20:11)", SystemErr: "warning"},
+		{Name: "Stack clear", Outcome: OutcomeTimeout, Message: "Jest ran for longer than 1000 ms"},
+	}
+
+	tests, err := typescriptParser{}.FileParse([]byte(report))
+	if err != nil {
+		t.Fatal(err)
+	}
+	classNames, got := listTests(tests)
+	if len(got) != len(want) {
+		t.Fatalf("got %d tests, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("test %d = %+v, want %+v", i, got[i], want[i])
+		}
+		if classNames[i] != want[i].Name {
+			t.Errorf("class %d = %s, want %s", i, classNames[i], want[i].Name)
+		}
+	}
+}
+
+func TestTypescriptTestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		testCase TypescriptTestCase
+		want     list.UnitTest
+	}{
+		{
+			name:     "passed",
+			testCase: TypescriptTestCase{Name: "push", Time: "0.25"},
+			want:     list.UnitTest{Name: "push", Outcome: "PASSED", Duration: 0.25},
+		},
+		{
+			name:     "failure without a message takes the first line of its body",
+			testCase: TypescriptTestCase{Name: "pop", Fail: &TypescriptFailureStatus{Body: "\n  Error: expected 2\n    at pop.test.ts:3\n"}},
+			want:     list.UnitTest{Name: "pop", Outcome: "FAILED", Message: "Error: expected 2", StackTrace: "Error: expected 2\n    at pop.test.ts:3"},
+		},
+		{
+			name:     "failure with a message",
+			testCase: TypescriptTestCase{Name: "pop", Fail: &TypescriptFailureStatus{Message: "expected 2", Body: "trace"}},
+			want:     list.UnitTest{Name: "pop", Outcome: "FAILED", Message: "expected 2", StackTrace: "trace"},
+		},
+		{
+			name:     "empty failure element",
+			testCase: TypescriptTestCase{Name: "pop", Fail: &TypescriptFailureStatus{}},
+			want:     list.UnitTest{Name: "pop", Outcome: "FAILED"},
+		},
+		{
+			name:     "skipped without a message",
+			testCase: TypescriptTestCase{Name: "peek", Skip: &TypescriptSkipStatus{}},
+			want:     list.UnitTest{Name: "peek", Outcome: "SKIPPED"},
+		},
+		{
+			name:     "error",
+			testCase: TypescriptTestCase{Name: "size", Error: &TypescriptErrorStatus{Body: "TypeError: size is not a function"}},
+			want:     list.UnitTest{Name: "size", Outcome: "ERRORED", Message: "TypeError: size is not a function", StackTrace: "TypeError: size is not a function"},
+		},
+		{
+			name:     "timeout",
+			testCase: TypescriptTestCase{Name: "clear", Error: &TypescriptErrorStatus{Message: "ran too long", Type: TimeoutErrorType}},
+			want:     list.UnitTest{Name: "clear", Outcome: OutcomeTimeout, Message: "ran too long"},
+		},
+		{
+			name:     "failure is reported over an error",
+			testCase: TypescriptTestCase{Name: "both", Fail: &TypescriptFailureStatus{Message: "failed"}, Error: &TypescriptErrorStatus{Message: "errored"}},
+			want:     list.UnitTest{Name: "both", Outcome: "FAILED", Message: "failed"},
+		},
+		{
+			name:     "captured output is trimmed",
+			testCase: TypescriptTestCase{Name: "log", Time: "not a number", SystemOut: "\n  out \n", SystemErr: " err\n"},
+			want:     list.UnitTest{Name: "log", Outcome: "PASSED", SystemOut: "out", SystemErr: "err"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.testCase.TestFormat(); got != test.want {
+				t.Errorf("TestFormat() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTypescriptSuiteErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		report string
+		want   []string // outcomes
+	}{
+		{
+			name: "suite that failed to run written as a failed test case",
+			report: `<testsuites>
+  <testsuite name="src/test/typescript/stack.test.ts" errors="1" failures="0" skipped="0" tests="0">
+    <testcase classname="Test suite failed to run" name="src/test/typescript/stack.test.ts" time="0">
+      <failure>src/stack.ts:3:5 - error TS2322: Type 'string' is not assignable to type 'number'.</failure>
+    </testcase>
+  </testsuite>
+</testsuites>`,
+			want: []string{"FAILED"},
+		},
+		{
+			name: "suite that failed to run without a test case",
+			report: `<testsuites>
+  <testsuite name="src/test/typescript/stack.test.ts" errors="1" failures="0" skipped="0" tests="0">
+    <system-err>Cannot find module '../stack'</system-err>
+  </testsuite>
+</testsuites>`,
+			want: []string{"ERRORED"},
+		},
+		{
+			name: "failing tests are not a suite error",
+			report: `<testsuites>
+  <testsuite name="Stack" errors="0" failures="1" skipped="0" tests="2">
+    <testcase classname="Stack push" name="Stack push"/>
+    <testcase classname="Stack pop" name="Stack pop"><failure/></testcase>
+  </testsuite>
+</testsuites>`,
+			want: []string{"PASSED", "FAILED"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := typescriptParser{}.FileParse([]byte(test.report))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, got := listTests(result)
+			if len(got) != len(test.want) {
+				t.Fatalf("got %d tests, want %d: %+v", len(got), len(test.want), got)
+			}
+			for i := range got {
+				if got[i].Outcome != test.want[i] {
+					t.Errorf("test %d outcome = %s, want %s", i, got[i].Outcome, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseJestVerboseOutput(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   []TestOutcome
+	}{
+		{
+			name: "nested describes",
+			output: `PASS src/test/typescript/stack.test.ts
+  Stack
+    ✓ push (2 ms)
+    pop
+      ✓ removes the last item (1 ms)
+      ✕ throws when empty (3 ms)
+    ✓ size
+`,
+			want: []TestOutcome{
+				{ClassName: "Stack push", Name: "Stack push", Outcome: "PASSED"},
+				{ClassName: "Stack pop removes the last item", Name: "Stack pop removes the last item", Outcome: "PASSED"},
+				{ClassName: "Stack pop throws when empty", Name: "Stack pop throws when empty", Outcome: "FAILED"},
+				{ClassName: "Stack size", Name: "Stack size", Outcome: "PASSED"},
+			},
+		},
+		{
+			name: "failure details and summary are skipped",
+			output: `FAIL src/test/typescript/queue.test.ts
+  ✕ dequeue (4 ms)
+
+  ● dequeue
+
+    expect(received).toBe(expected)
+      ✓ not a test
+
+Test Suites: 1 failed, 1 total
+Tests:       1 failed, 1 total
+`,
+			want: []TestOutcome{
+				{ClassName: "dequeue", Name: "dequeue", Outcome: "FAILED"},
+			},
+		},
+		{
+			name:   "skipped and todo tests",
+			output: "PASS a.test.ts\n  Queue\n    ○ skipped peek\n    ✎ todo clear\n",
+			want: []TestOutcome{
+				{ClassName: "Queue peek", Name: "Queue peek", Outcome: "SKIPPED"},
+				{ClassName: "Queue clear", Name: "Queue clear", Outcome: "SKIPPED"},
+			},
+		},
+		{
+			name:   "colors and windows symbols",
+			output: "\x1b[42mPASS\x1b[49m a.test.ts\r\n  \x1b[32m√\x1b[39m \x1b[2madds (1.5 ms)\x1b[22m\r\n  \x1b[31m×\x1b[39m subtracts\r\n",
+			want: []TestOutcome{
+				{ClassName: "adds", Name: "adds", Outcome: "PASSED"},
+				{ClassName: "subtracts", Name: "subtracts", Outcome: "FAILED"},
+			},
+		},
+		{
+			name:   "describes do not carry over to the next file",
+			output: "PASS a.test.ts\n  Stack\n    ✓ push\nPASS b.test.ts\n  ✓ alone\n",
+			want: []TestOutcome{
+				{ClassName: "Stack push", Name: "Stack push", Outcome: "PASSED"},
+				{ClassName: "alone", Name: "alone", Outcome: "PASSED"},
+			},
+		},
+		{
+			name:   "killed before any file finished",
+			output: "> jest --verbose\n\nDetermining test suites to run...",
+			want:   nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := ParseJestVerboseOutput(test.output)
+			if len(got) != len(test.want) {
+				t.Fatalf("got %d outcomes, want %d: %v", len(got), len(test.want), got)
+			}
+			for i := range got {
+				if got[i] != test.want[i] {
+					t.Errorf("outcome %d = %+v, want %+v", i, got[i], test.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,595 @@
+package typescript
+
+import (
+	"SubmissionGrader/internal/complexity/complexCommons"
+	parser "SubmissionGrader/internal/complexity/typescript/typeScriptAntlrParser"
+	"regexp"
+	"strings"
+	"unicode"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// The generated lexer was made from a grammar older than TypeScript 4, and the grammar
+// it came from is not kept with it, so newer syntax is rewritten into syntax it knows
+// before lexing. Every rewrite keeps the same number of characters so lines, columns
+// and token indexes still point at the original file.
+//
+//	a?.b        a .b    (the . is remembered as optional)
+//	a?.[i]      a  [i]  (the [ is remembered as optional)
+//	a?.(x)      a  (x)  (the ( is remembered as optional)
+//	a ?? b      a || b  (counted the same way as ||)
+//	a ??= b     a  |= b (remembered as a logical assignment, counted like ||)
+//	a ||= b     a  |= b
+//	a &&= b     a  &= b
+//	this.#x     this.$x (the token of the name gets its # back, see sourceLexer)
+//	x satisfies T       x as        T
+//	override m()        m()
+//	keyof T     T
+//	infer U     U
+//	type E = `on${K}-${V}`  type E = (   K  |  V)  (template literal types of type aliases,
+//	type N = `none`         type N = '    '         as the types they are made of)
+//	g(a, b,)    g(a, b )   (trailing commas of parameters and type parameters)
+//	<T,>        <T >
+//	await x     void x  (remembered as an await)
+//	for await (x of xs) for       (x of xs)
+//	async function f()  function f()  (the function is remembered as async,
+//	async m()           m()           and so are methods and object methods)
+//	import x from 'm'   import{x}from 'm'
+//	import 'm'          (left as they are, the module is remembered
+//	export { x } from 'm'   as an import or an export)
+//
+// Type aliases, conditional types among them, are often read as expressions by the generated parser,
+// which is enough since no code that is counted is ever inside a type.
+type rewrittenSource struct {
+	text string
+	// indexes (in runes) of the tokens that stand for an optional chain, see isOptionalChain
+	optionalChains map[int]bool
+	// indexes of the |= and &= tokens that were a ??=, ||= or &&=
+	logicalAssignments map[int]bool
+	// indexes of the private names, whose # was rewritten into a $
+	privateNames map[int]bool
+	// indexes of the void tokens that were an await
+	awaits map[int]bool
+	// indexes of the first token after an async that was taken out, see isAsyncFunction
+	asyncFunctions map[int]bool
+	// names of the functions, methods and bound arrow functions declared async in the file
+	asyncNames map[string]bool
+	// how many times each JSX tag name was used before rewriteJsx blanked them out
+	jsxNames map[string]int
+	// imports and exports the grammar does not see as such, see rewriteImport and findReexport
+	unparsedImports []complexCommons.ImportInfo
+}
+
+// rewriteModernSyntax
+// Goes through the source skipping comments, strings and regular expressions, rewriting what the lexer does not know
+func rewriteModernSyntax(source string) rewrittenSource {
+	runes := []rune(source)
+	result := rewrittenSource{
+		optionalChains:     map[int]bool{},
+		logicalAssignments: map[int]bool{},
+		privateNames:       map[int]bool{},
+		awaits:             map[int]bool{},
+		asyncFunctions:     map[int]bool{},
+		asyncNames:         map[string]bool{},
+	}
+	optionalChains := result.optionalChains
+
+	const (
+		stateCode = iota
+		stateLineComment
+		stateBlockComment
+		stateSingleQuote
+		stateDoubleQuote
+		stateTemplate
+		stateRegex
+		stateRegexClass // inside [ ] of a regular expression, where / does not end it
+	)
+	state := stateCode
+	var templateDepths []int // brace depth of each ${ } we are in, so the } that ends it is found
+	braceDepth := 0
+
+	for i := 0; i < len(runes); i++ {
+		current := runes[i]
+		next := runeAt(runes, i+1)
+
+		switch state {
+		case stateLineComment:
+			if current == '\n' {
+				state = stateCode
+			}
+			continue
+		case stateBlockComment:
+			if current == '*' && next == '/' {
+				state = stateCode
+				i++
+			}
+			continue
+		case stateSingleQuote, stateDoubleQuote:
+			if current == '\\' {
+				i++
+			} else if (state == stateSingleQuote && current == '\'') || (state == stateDoubleQuote && current == '"') || current == '\n' {
+				state = stateCode
+			}
+			continue
+		case stateTemplate:
+			if current == '\\' {
+				i++
+			} else if current == '`' {
+				state = stateCode
+			} else if current == '$' && next == '{' {
+				templateDepths = append(templateDepths, braceDepth)
+				braceDepth++
+				state = stateCode
+				i++
+			}
+			continue
+		case stateRegex:
+			if current == '\\' {
+				i++
+			} else if current == '[' {
+				state = stateRegexClass
+			} else if current == '/' || current == '\n' {
+				state = stateCode
+			}
+			continue
+		case stateRegexClass:
+			if current == '\\' {
+				i++
+			} else if current == ']' {
+				state = stateRegex
+			} else if current == '\n' {
+				state = stateCode
+			}
+			continue
+		}
+
+		switch {
+		case current == '/' && next == '/':
+			state = stateLineComment
+			i++
+		case current == '/' && next == '*':
+			state = stateBlockComment
+			i++
+		case current == '/' && isRegexStart(runes, i):
+			state = stateRegex
+		case current == '\'':
+			state = stateSingleQuote
+		case current == '"':
+			state = stateDoubleQuote
+		case current == '`' && isInTypeAlias(runes, i) && rewriteTemplateType(runes, i):
+			i-- // read again as the type it became
+		case current == '`':
+			state = stateTemplate
+		case current == '{':
+			braceDepth++
+		case current == '}':
+			braceDepth--
+			if len(templateDepths) > 0 && templateDepths[len(templateDepths)-1] == braceDepth {
+				templateDepths = templateDepths[:len(templateDepths)-1]
+				state = stateTemplate
+			}
+
+		case current == '?' && next == '.' && !unicode.IsDigit(runeAt(runes, i+2)): // a ?.5 : b is a ternary
+			switch runeAt(runes, i+2) {
+			case '[', '(':
+				runes[i], runes[i+1] = ' ', ' '
+				optionalChains[i+2] = true
+			default:
+				runes[i] = ' '
+				optionalChains[i+1] = true
+			}
+			i++
+		case current == '?' && next == '?':
+			if runeAt(runes, i+2) == '=' {
+				runes[i], runes[i+1] = ' ', '|'
+				result.logicalAssignments[i+1] = true
+			} else {
+				runes[i], runes[i+1] = '|', '|'
+			}
+			i++
+		case (current == '|' || current == '&') && next == current && runeAt(runes, i+2) == '=':
+			runes[i] = ' '
+			result.logicalAssignments[i+1] = true
+			i++
+		case current == ',' && strings.ContainsRune(")>", nextNonSpace(runes, i+1)):
+			runes[i] = ' '
+		case current == '#' && isIdentifierStart(next):
+			runes[i] = '$'
+			result.privateNames[i] = true
+
+		case isIdentifierStart(current) && !isIdentifierPart(runeAt(runes, i-1)):
+			end := i
+			for end < len(runes) && isIdentifierPart(runes[end]) {
+				end++
+			}
+			switch string(runes[i:end]) {
+			case "await":
+				rewriteAwait(runes, i, end, result.awaits)
+			case "async":
+				rewriteAsync(runes, i, end, result)
+			case "import":
+				rewriteImport(runes, i, end, &result)
+			case "export":
+				findReexport(runes, i, end, &result)
+			default:
+				rewriteContextualKeyword(runes, i, end)
+			}
+			i = end - 1
+		}
+	}
+
+	result.text = string(runes)
+	return result
+}
+
+// sourceLexer
+// Lexes the rewritten source, giving private names back the # that was rewritten into a $,
+// so they are reported and matched the way they are written
+type sourceLexer struct {
+	*parser.TypeScriptLexer
+	privateNames map[int]bool
+}
+
+func newSourceLexer(source rewrittenSource) *sourceLexer {
+	return &sourceLexer{
+		TypeScriptLexer: parser.NewTypeScriptLexer(antlr.NewInputStream(source.text)),
+		privateNames:    source.privateNames,
+	}
+}
+
+func (l *sourceLexer) NextToken() antlr.Token {
+	token := l.TypeScriptLexer.NextToken()
+	if l.privateNames[token.GetStart()] {
+		token.SetText("#" + token.GetText()[1:])
+	}
+	return token
+}
+
+// Words after which a / starts a regular expression rather than dividing
+var regexKeywords = map[string]bool{
+	"return": true, "typeof": true, "instanceof": true, "in": true, "of": true, "new": true, "delete": true,
+	"void": true, "throw": true, "case": true, "do": true, "else": true, "yield": true, "await": true,
+}
+
+// isRegexStart
+// A / starts a regular expression unless it follows something that has a value,
+// in which case it divides it. This is the same guess the lexer makes
+func isRegexStart(runes []rune, index int) bool {
+	previousIndex := index - 1
+	for previousIndex >= 0 && unicode.IsSpace(runes[previousIndex]) {
+		previousIndex--
+	}
+	previous := runeAt(runes, previousIndex)
+	switch {
+	case previous == 0:
+		return true
+	case isIdentifierPart(previous):
+		return regexKeywords[previousWord(runes, index)]
+	case previous == ')' || previous == ']' || previous == '}':
+		return false
+	case (previous == '+' || previous == '-') && runeAt(runes, previousIndex-1) == previous: // i++ / 2
+		return false
+	}
+	return true
+}
+
+// typeAliasStart
+// The start of a statement declaring a type alias, up to its =
+var typeAliasStart = regexp.MustCompile(`^\s*(export\s+)?(declare\s+)?type\s+[\p{L}_$][\p{L}\p{N}_$]*\s*(<[\s\S]*>)?\s*=`)
+
+// isInTypeAlias
+// Checks if the statement the index is in declares a type alias, going back to the last ; or }
+func isInTypeAlias(runes []rune, index int) bool {
+	start := index
+	for start > 0 && runes[start-1] != ';' && runes[start-1] != '}' {
+		start--
+	}
+	return typeAliasStart.MatchString(string(runes[start:index]))
+}
+
+// rewriteTemplateType
+// Rewrites the template literal type starting at start into the union of its substitutions in parentheses,
+// or into a string when it has none, keeping line breaks. Gives false and leaves it alone when it does not end
+func rewriteTemplateType(runes []rune, start int) bool {
+	var substitutions [][2]int // the indexes of each ${ and its }
+	end := -1
+	for i := start + 1; i < len(runes) && end == -1; i++ {
+		switch {
+		case runes[i] == '\\':
+			i++
+		case runes[i] == '`':
+			end = i
+		case runes[i] == '$' && runeAt(runes, i+1) == '{':
+			depth := 0
+			close := i + 1
+			for ; close < len(runes); close++ {
+				if runes[close] == '`' {
+					return false
+				}
+				if runes[close] == '{' {
+					depth++
+				} else if runes[close] == '}' {
+					depth--
+					if depth == 0 {
+						break
+					}
+				}
+			}
+			if close == len(runes) {
+				return false
+			}
+			substitutions = append(substitutions, [2]int{i, close})
+			i = close
+		}
+	}
+	if end == -1 || (len(substitutions) == 0 && strings.ContainsRune(string(runes[start:end]), '\n')) {
+		return false
+	}
+
+	inSubstitution := func(index int) bool {
+		for _, substitution := range substitutions {
+			if index > substitution[0]+1 && index < substitution[1] {
+				return true
+			}
+		}
+		return false
+	}
+	for i := start + 1; i < end; i++ {
+		if !inSubstitution(i) && runes[i] != '\n' {
+			runes[i] = ' '
+		}
+	}
+	if len(substitutions) == 0 {
+		runes[start], runes[end] = '\'', '\''
+		return true
+	}
+	for _, substitution := range substitutions[:len(substitutions)-1] {
+		runes[substitution[1]] = '|'
+	}
+	runes[start], runes[end] = '(', ')'
+	return true
+}
+
+// rewriteContextualKeyword
+// satisfies, override, keyof and infer are only rewritten where they are used as keywords,
+// so a property or variable with the same name is left alone
+func rewriteContextualKeyword(runes []rune, start int, end int) {
+	word := string(runes[start:end])
+	if word != "satisfies" && word != "override" && word != "keyof" && word != "infer" {
+		return
+	}
+	if previous := previousNonSpace(runes, start); previous == '.' {
+		return
+	}
+	if !unicode.IsSpace(runeAt(runes, end)) {
+		return
+	}
+	following := nextNonSpace(runes, end)
+	if !isIdentifierStart(following) && following != '{' && following != '[' && following != '(' && following != '#' {
+		return
+	}
+
+	blankRunes(runes, start, end)
+	if word == "satisfies" {
+		runes[start], runes[start+1] = 'a', 's'
+	}
+}
+
+// rewriteAwait
+// void has the same precedence as await, so await x becomes void x. An await that is not
+// followed by an expression is a variable of that name and is left alone
+func rewriteAwait(runes []rune, start int, end int, awaits map[int]bool) {
+	if previousNonSpace(runes, start) == '.' {
+		return
+	}
+	if previousWord(runes, start) == "for" { // for await (x of xs) waits on purpose
+		blankRunes(runes, start, end)
+		return
+	}
+	following := nextNonSpace(runes, end)
+	if !isIdentifierPart(following) && !strings.ContainsRune("([`'\"!-+~", following) {
+		return
+	}
+	copy(runes[start:end], []rune("void "))
+	awaits[start] = true
+}
+
+// rewriteAsync
+// The grammar only knows async on arrow functions and on class members without static,
+// so it is taken out of functions, methods and generators, remembering the token it was on.
+// The async of an arrow function is kept, only the name it is bound to is remembered
+func rewriteAsync(runes []rune, start int, end int, source rewrittenSource) {
+	if previousNonSpace(runes, start) == '.' {
+		return
+	}
+	next := nextNonSpaceIndex(runes, end)
+	if next == end && runeAt(runes, next) != '*' { // async( is an arrow function or a call
+		if name := getBoundName(runes, start); name != "" {
+			source.asyncNames[name] = true
+		}
+		return
+	}
+	word := identifierAt(runes, next)
+	name := ""
+	switch {
+	case word == "function":
+		name = identifierAt(runes, nextNonSpaceIndex(runes, next+len(word)))
+		if name == "" {
+			name = getBoundName(runes, start)
+		}
+	case runeAt(runes, next) == '*':
+		name = identifierAt(runes, nextNonSpaceIndex(runes, next+1))
+	case word != "" && strings.ContainsRune("(<", nextNonSpace(runes, next+len(word))):
+		name = word
+	default:
+		if name := getBoundName(runes, start); name != "" {
+			source.asyncNames[name] = true
+		}
+		return
+	}
+
+	blankRunes(runes, start, end)
+	source.asyncFunctions[next] = true
+	if name != "" {
+		source.asyncNames[name] = true
+	}
+}
+
+// rewriteImport
+// The grammar only knows import * as x and import { x } from a module, so import x becomes
+// import{x} using the spaces around the name. That cannot be done when one of them is a line break,
+// which would move every line after it, so those imports are left as they are.
+// import 'm' is read as an expression, so its module is remembered instead
+func rewriteImport(runes []rune, start int, end int, source *rewrittenSource) {
+	if previousNonSpace(runes, start) == '.' {
+		return
+	}
+	next := nextNonSpaceIndex(runes, end)
+	if module, ok := stringAt(runes, next); ok {
+		source.addUnparsedImport(runes, start, module, complexCommons.ImportKindImport)
+		return
+	}
+
+	name := identifierAt(runes, next)
+	if name == "" || name == "from" || name == "type" {
+		return
+	}
+	after := next + len(name)
+	if identifierAt(runes, nextNonSpaceIndex(runes, after)) != "from" {
+		return
+	}
+	if !isInlineSpace(runeAt(runes, next-1)) || !isInlineSpace(runeAt(runes, after)) {
+		return
+	}
+	runes[next-1], runes[after] = '{', '}'
+}
+
+// findReexport
+// export { x } from 'm' is read as an exported block followed by an expression, so its module is remembered
+func findReexport(runes []rune, start int, end int, source *rewrittenSource) {
+	next := nextNonSpaceIndex(runes, end)
+	if previousNonSpace(runes, start) == '.' || runeAt(runes, next) != '{' {
+		return
+	}
+	closing := next
+	for closing < len(runes) && runes[closing] != '}' {
+		closing++
+	}
+	from := nextNonSpaceIndex(runes, closing+1)
+	if identifierAt(runes, from) != "from" {
+		return
+	}
+	if module, ok := stringAt(runes, nextNonSpaceIndex(runes, from+len("from"))); ok {
+		source.addUnparsedImport(runes, start, module, complexCommons.ImportKindExport)
+	}
+}
+
+func (r *rewrittenSource) addUnparsedImport(runes []rune, start int, module string, kind string) {
+	line := 1 + strings.Count(string(runes[:start]), "\n")
+	r.unparsedImports = append(r.unparsedImports, complexCommons.ImportInfo{Module: module, Line: line, Kind: kind})
+}
+
+// stringAt
+// Gets the value of the string literal starting at index, if there is one
+func stringAt(runes []rune, index int) (string, bool) {
+	quote := runeAt(runes, index)
+	if quote != '\'' && quote != '"' {
+		return "", false
+	}
+	closing := index + 1
+	for closing < len(runes) && runes[closing] != quote && runes[closing] != '\n' {
+		closing++
+	}
+	return string(runes[index+1 : closing]), true
+}
+
+func isInlineSpace(r rune) bool {
+	return r == ' ' || r == '\t'
+}
+
+// getBoundName
+// Gets the name an async function expression is given, from name = async or name: async
+func getBoundName(runes []rune, start int) string {
+	i := start - 1
+	for i >= 0 && unicode.IsSpace(runes[i]) {
+		i--
+	}
+	if i < 1 || (runes[i] != '=' && runes[i] != ':') || strings.ContainsRune("=!<>", runes[i-1]) {
+		return ""
+	}
+	return previousWord(runes, i)
+}
+
+func blankRunes(runes []rune, start int, end int) {
+	for i := start; i < end; i++ {
+		runes[i] = ' '
+	}
+}
+
+// previousWord
+// Gets the identifier that ends right before index, skipping whitespace
+func previousWord(runes []rune, index int) string {
+	end := index
+	for end > 0 && unicode.IsSpace(runes[end-1]) {
+		end--
+	}
+	start := end
+	for start > 0 && isIdentifierPart(runes[start-1]) {
+		start--
+	}
+	return string(runes[start:end])
+}
+
+// identifierAt
+// Gets the identifier starting at index, or nothing if there is none
+func identifierAt(runes []rune, index int) string {
+	if !isIdentifierStart(runeAt(runes, index)) {
+		return ""
+	}
+	end := index
+	for end < len(runes) && isIdentifierPart(runes[end]) {
+		end++
+	}
+	return string(runes[index:end])
+}
+
+func runeAt(runes []rune, index int) rune {
+	if index < 0 || index >= len(runes) {
+		return 0
+	}
+	return runes[index]
+}
+
+func previousNonSpace(runes []rune, index int) rune {
+	for i := index - 1; i >= 0; i-- {
+		if !unicode.IsSpace(runes[i]) {
+			return runes[i]
+		}
+	}
+	return 0
+}
+
+func nextNonSpace(runes []rune, index int) rune {
+	for i := index; i < len(runes); i++ {
+		if !unicode.IsSpace(runes[i]) {
+			return runes[i]
+		}
+	}
+	return 0
+}
+
+func nextNonSpaceIndex(runes []rune, index int) int {
+	for index < len(runes) && unicode.IsSpace(runes[index]) {
+		index++
+	}
+	return index
+}
+
+func isIdentifierStart(r rune) bool {
+	return unicode.IsLetter(r) || r == '_' || r == '$'
+}
+
+func isIdentifierPart(r rune) bool {
+	return isIdentifierStart(r) || unicode.IsDigit(r)
+}
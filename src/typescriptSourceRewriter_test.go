@@ -0,0 +1,118 @@
+package typescript
+
+import (
+	"sort"
+	"testing"
+)
+
+func markedIndexes(marks map[int]bool) []int {
+	indexes := []int{}
+	for index := range marks {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+func sameIndexes(got []int, want []int) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRewriteModernSyntax(t *testing.T) {
+	tests := []struct {
+		name           string
+		source         string
+		want           string
+		optionalChains []int
+		logicalAssigns []int
+		privateNames   []int
+		awaits         []int
+		asyncFunctions []int
+		asyncNames     []string
+	}{
+		{name: "optional member", source: "a?.b", want: "a .b", optionalChains: []int{2}},
+		{name: "optional index", source: "a?.[i]", want: "a  [i]", optionalChains: []int{3}},
+		{name: "optional call", source: "a?.(x)", want: "a  (x)", optionalChains: []int{3}},
+		{name: "ternary with a decimal", source: "a ?.5 : b", want: "a ?.5 : b"},
+		{name: "nullish coalescing", source: "a ?? b", want: "a || b"},
+		{name: "nullish assignment", source: "a ??= b", want: "a  |= b", logicalAssigns: []int{3}},
+		{name: "logical or assignment", source: "a ||= b", want: "a  |= b", logicalAssigns: []int{3}},
+		{name: "logical and assignment", source: "a &&= b", want: "a  &= b", logicalAssigns: []int{3}},
+		{name: "bitwise assignment", source: "a |= b", want: "a |= b"},
+		{name: "private field", source: "this.#x", want: "this.$x", privateNames: []int{5}},
+		{name: "satisfies", source: "x satisfies T", want: "x as        T"},
+		{name: "override", source: "override m() {}", want: "         m() {}"},
+		{name: "keyof", source: "let k: keyof T", want: "let k:       T"},
+		{name: "infer", source: "type E<T> = T extends (infer U)[] ? U : T", want: "type E<T> = T extends (      U)[] ? U : T"},
+		{name: "template literal type", source: "type G<K> = `get${Capitalize<K>}`", want: "type G<K> = (     Capitalize<K> )"},
+		{name: "template literal type with inferred parts", source: "type S<T> = T extends `${infer H}-${infer R}` ? H : R", want: "type S<T> = T extends (        H|         R ) ? H : R"},
+		{name: "template literal type without substitutions", source: "export type N = `none` | `on${string}`", want: "export type N = '    ' | (    string )"},
+		{name: "template literal outside of a type alias", source: "const s = `get${x}`; type A = 1", want: "const s = `get${x}`; type A = 1"},
+		{name: "trailing comma of parameters", source: "function g(a: number,) {}", want: "function g(a: number ) {}"},
+		{name: "trailing comma of type parameters", source: "const f = <T,>(x: T) => x", want: "const f = <T >(x: T) => x"},
+		{name: "commas inside a list are kept", source: "f(a, b); [1, 2,]", want: "f(a, b); [1, 2,]"},
+		{name: "keyword used as a property", source: "a.keyof T; b.override(x)", want: "a.keyof T; b.override(x)"},
+		{name: "await", source: "await x", want: "void  x", awaits: []int{0}},
+		{name: "variable named await", source: "await;", want: "await;"},
+		{name: "for await", source: "for await (x of xs) {}", want: "for       (x of xs) {}"},
+		{name: "async function", source: "async function f() {}", want: "      function f() {}", asyncFunctions: []int{6}, asyncNames: []string{"f"}},
+		{name: "async method", source: "async m() {}", want: "      m() {}", asyncFunctions: []int{6}, asyncNames: []string{"m"}},
+		{name: "async generator", source: "async *g() {}", want: "      *g() {}", asyncFunctions: []int{6}, asyncNames: []string{"g"}},
+		{name: "async arrow function is kept", source: "const f = async () => 1", want: "const f = async () => 1", asyncNames: []string{"f"}},
+		{name: "strings are left alone", source: `"a?.b" + 'c ?? d' + ` + "`${e?.f} g?.h`", want: `"a?.b" + 'c ?? d' + ` + "`${e .f} g?.h`", optionalChains: []int{25}},
+		{name: "comments are left alone", source: "// a?.b\n/* c ?? d */ e", want: "// a?.b\n/* c ?? d */ e"},
+		{name: "default import", source: "import _ from 'lodash'", want: "import{_}from 'lodash'"},
+		{name: "default import over two lines", source: "import\n_ from 'lodash'", want: "import\n_ from 'lodash'"},
+		{name: "default and named imports", source: "import _, { map } from 'lodash'", want: "import _, { map } from 'lodash'"},
+		{name: "regular expression", source: "const r = /a?.b|c??/g; x?.y", want: "const r = /a?.b|c??/g; x .y", optionalChains: []int{25}},
+		{name: "regular expression with quotes", source: `if (/['"]/.test(s)) a ?? b`, want: `if (/['"]/.test(s)) a || b`},
+		{name: "slash in a character class", source: "return /[/?.]/.test(s) ?? t", want: "return /[/?.]/.test(s) || t"},
+		{name: "escaped slash", source: `s.replace(/\/?.x/, '') ?? t`, want: `s.replace(/\/?.x/, '') || t`},
+		{name: "division", source: "a / b ?? c / d", want: "a / b || c / d"},
+		{name: "division after a call", source: "f(a) / 2 / g?.h", want: "f(a) / 2 / g .h", optionalChains: []int{13}},
+		{name: "division after an increment", source: "i++ / 2 ?? j", want: "i++ / 2 || j"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := rewriteModernSyntax(test.source)
+			if result.text != test.want {
+				t.Errorf("text = %q, want %q", result.text, test.want)
+			}
+			if len(result.text) != len(test.source) {
+				t.Errorf("length changed from %d to %d", len(test.source), len(result.text))
+			}
+			if got := markedIndexes(result.optionalChains); !sameIndexes(got, test.optionalChains) {
+				t.Errorf("optional chains = %v, want %v", got, test.optionalChains)
+			}
+			if got := markedIndexes(result.logicalAssignments); !sameIndexes(got, test.logicalAssigns) {
+				t.Errorf("logical assignments = %v, want %v", got, test.logicalAssigns)
+			}
+			if got := markedIndexes(result.privateNames); !sameIndexes(got, test.privateNames) {
+				t.Errorf("private names = %v, want %v", got, test.privateNames)
+			}
+			if got := markedIndexes(result.awaits); !sameIndexes(got, test.awaits) {
+				t.Errorf("awaits = %v, want %v", got, test.awaits)
+			}
+			if got := markedIndexes(result.asyncFunctions); !sameIndexes(got, test.asyncFunctions) {
+				t.Errorf("async functions = %v, want %v", got, test.asyncFunctions)
+			}
+			if len(result.asyncNames) != len(test.asyncNames) {
+				t.Errorf("async names = %v, want %v", result.asyncNames, test.asyncNames)
+			}
+			for _, name := range test.asyncNames {
+				if !result.asyncNames[name] {
+					t.Errorf("async names = %v, want %v", result.asyncNames, test.asyncNames)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package typescript
+
+import (
+	"SubmissionGrader/internal/complexity/complexCommons"
+	parser "SubmissionGrader/internal/complexity/typescript/typeScriptAntlrParser"
+	"fmt"
+	"sort"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// syntaxErrorListener
+// Collects the errors of the lexer and parser instead of printing them to the console
+type syntaxErrorListener struct {
+	*antlr.DefaultErrorListener
+	fileName         string
+	diagnostics      []complexCommons.Diagnostic
+	failedPredicates []complexCommons.Diagnostic
+}
+
+func newSyntaxErrorListener(fileName string) *syntaxErrorListener {
+	return &syntaxErrorListener{
+		DefaultErrorListener: antlr.NewDefaultErrorListener(),
+		fileName:             fileName,
+	}
+}
+
+// SyntaxError
+// ANTLR counts columns from 0, they are counted from 1 to match editors.
+// The grammar's predicates fail at the start of a statement whenever the parser falls back
+// to full context for an error further on, so those are kept apart from the real errors
+func (s *syntaxErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	diagnostic := complexCommons.Diagnostic{
+		FileName: s.fileName,
+		Line:     line,
+		Column:   column + 1,
+		Message:  msg,
+	}
+	if _, ok := e.(*antlr.FailedPredicateException); ok {
+		s.failedPredicates = append(s.failedPredicates, diagnostic)
+		return
+	}
+	s.diagnostics = append(s.diagnostics, diagnostic)
+}
+
+// addUnexpectedCharacters
+// The lexer puts characters it does not know, like the quote of an unterminated string,
+// on a channel of their own instead of reporting them
+func (s *syntaxErrorListener) addUnexpectedCharacters(tokens []antlr.Token) {
+	for _, token := range tokens {
+		if token.GetTokenType() != parser.TypeScriptLexerUnexpectedCharacter {
+			continue
+		}
+		s.diagnostics = append(s.diagnostics, complexCommons.Diagnostic{
+			FileName: s.fileName,
+			Line:     token.GetLine(),
+			Column:   token.GetColumn() + 1,
+			Message:  fmt.Sprintf("unexpected character %q", token.GetText()),
+		})
+	}
+	sort.SliceStable(s.diagnostics, func(i, j int) bool {
+		if s.diagnostics[i].Line != s.diagnostics[j].Line {
+			return s.diagnostics[i].Line < s.diagnostics[j].Line
+		}
+		return s.diagnostics[i].Column < s.diagnostics[j].Column
+	})
+}
+
+// getDiagnostics
+// Failed predicates are only given when nothing else was reported
+func (s *syntaxErrorListener) getDiagnostics() []complexCommons.Diagnostic {
+	if len(s.diagnostics) == 0 {
+		return s.failedPredicates
+	}
+	return s.diagnostics
+}
@@ -0,0 +1,430 @@
+package typescript
+
+import (
+	methodInfoType "SubmissionGrader/internal/complexity/methodInfo"
+	parser "SubmissionGrader/internal/complexity/typescript/typeScriptAntlrParser"
+	//"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/antlr4-go/antlr/v4"
+)
+
+// GetParameterText
+// Goes through every token inside the parameter list and puts
+// them together separated by spaces.
+// If there is no parameter list, an empty string is returned
+func GetParameterText(tree antlr.Tree) string {
+	if tree == nil {
+		return ""
+	}
+	content := ""
+	for _, token := range GetTerminalsOfTree(tree) {
+		content += token.GetText() + " "
+	}
+	return content
+}
+
+// GetParameters
+// Gets each parameter of a ParameterList, FormalParameterList or single
+// parameter (such as x in x => x * 2) along with what was declared about it
+func GetParameters(tree antlr.Tree) []methodInfoType.ParameterInfo {
+	parameters := []methodInfoType.ParameterInfo{}
+
+	switch parameterList := tree.(type) {
+	case *parser.ParameterListContext:
+		for _, parameter := range parameterList.AllParameter() {
+			declared, ok := parameter.(*parser.ParameterContext)
+			if !ok {
+				continue
+			}
+			if required, ok := declared.RequiredParameter().(*parser.RequiredParameterContext); ok {
+				parameters = append(parameters, getRequiredParameter(required))
+			} else if optional, ok := declared.OptionalParameter().(*parser.OptionalParameterContext); ok {
+				parameters = append(parameters, getOptionalParameter(optional))
+			}
+		}
+		if rest, ok := parameterList.RestParameter().(*parser.RestParameterContext); ok {
+			parameters = append(parameters, methodInfoType.ParameterInfo{
+				Name: getSourceText(rest.SingleExpression()),
+				Type: getAnnotatedType(rest.TypeAnnotation()),
+				Rest: true,
+			})
+		}
+
+	case *parser.FormalParameterListContext:
+		for _, parameter := range parameterList.AllFormalParameterArg() {
+			if arg, ok := parameter.(*parser.FormalParameterArgContext); ok {
+				parameters = append(parameters, getFormalParameter(arg))
+			}
+		}
+		if last, ok := parameterList.LastFormalParameterArg().(*parser.LastFormalParameterArgContext); ok {
+			parameters = append(parameters, methodInfoType.ParameterInfo{
+				Name: getSourceText(last.Identifier()),
+				Type: getAnnotatedType(last.TypeAnnotation()),
+				Rest: true,
+			})
+		}
+		if parameterList.ArrayLiteral() != nil || parameterList.ObjectLiteral() != nil { // destructured
+			pattern := antlr.Tree(parameterList.ObjectLiteral())
+			if parameterList.ArrayLiteral() != nil {
+				pattern = parameterList.ArrayLiteral()
+			}
+			parameters = append(parameters, methodInfoType.ParameterInfo{Name: getSourceText(pattern)})
+		}
+
+	case antlr.TerminalNode:
+		parameters = append(parameters, methodInfoType.ParameterInfo{Name: parameterList.GetText()})
+
+	case *parser.BindingPatternContext:
+		parameters = append(parameters, methodInfoType.ParameterInfo{Name: getSourceText(parameterList)})
+	}
+	return parameters
+}
+
+func getRequiredParameter(ctx *parser.RequiredParameterContext) methodInfoType.ParameterInfo {
+	return methodInfoType.ParameterInfo{
+		Name:          getSourceText(ctx.IdentifierOrPattern()),
+		Type:          getAnnotatedType(ctx.TypeAnnotation()),
+		Accessibility: getSourceText(ctx.AccessibilityModifier()),
+	}
+}
+
+func getOptionalParameter(ctx *parser.OptionalParameterContext) methodInfoType.ParameterInfo {
+	parameter := methodInfoType.ParameterInfo{
+		Name:          getSourceText(ctx.IdentifierOrPattern()),
+		Type:          getAnnotatedType(ctx.TypeAnnotation()),
+		Optional:      true,
+		Accessibility: getSourceText(ctx.AccessibilityModifier()),
+	}
+	if initializer, ok := ctx.Initializer().(*parser.InitializerContext); ok {
+		parameter.DefaultValue = getSourceText(initializer.SingleExpression())
+	}
+	return parameter
+}
+
+func getFormalParameter(ctx *parser.FormalParameterArgContext) methodInfoType.ParameterInfo {
+	parameter := methodInfoType.ParameterInfo{
+		Name:          getSourceText(ctx.IdentifierOrKeyWord()),
+		Type:          getAnnotatedType(ctx.TypeAnnotation()),
+		Optional:      ctx.QuestionMark() != nil || ctx.Assign() != nil,
+		Accessibility: getSourceText(ctx.AccessibilityModifier()),
+	}
+	if ctx.Assign() != nil {
+		parameter.DefaultValue = getSourceText(ctx.SingleExpression())
+	}
+	return parameter
+}
+
+// getAnnotatedType
+// Gets the type out of a type annotation (: number) without the colon
+func getAnnotatedType(annotation parser.ITypeAnnotationContext) string {
+	typeAnnotation, ok := annotation.(*parser.TypeAnnotationContext)
+	if !ok {
+		return ""
+	}
+	return getSourceText(typeAnnotation.Type_())
+}
+
+// getSourceText
+// Gets the text of the tree the way it was written, with any whitespace between tokens as one space.
+// GetText would join the tokens with nothing between them, turning new Map() into newMap()
+func getSourceText(tree antlr.Tree) string {
+	if tree == nil {
+		return ""
+	}
+	return getSourceTextUntil(tree, nil)
+}
+
+// getSourceTextUntil
+// Same as getSourceText, stopping after the stop token when there is one.
+// No space is put around a dot, which is where a?.b was rewritten to a .b
+func getSourceTextUntil(tree antlr.Tree, stop antlr.Token) string {
+	text := ""
+	var previous antlr.Token
+	for _, token := range GetTerminalsOfTree(tree) {
+		if stop != nil && token.GetTokenIndex() > stop.GetTokenIndex() {
+			break
+		}
+		if previous != nil && token.GetStart() > previous.GetStop()+1 && previous.GetText() != "." && token.GetText() != "." {
+			text += " "
+		}
+		text += token.GetText()
+		previous = token
+	}
+	return text
+}
+
+// getTypeReferenceName
+// Gets the name of a type reference without its generic arguments, such as Base for Base<T>
+func getTypeReferenceName(typeReference parser.ITypeReferenceContext) string {
+	reference, ok := typeReference.(*parser.TypeReferenceContext)
+	if !ok || reference.TypeName() == nil {
+		return ""
+	}
+	return reference.TypeName().GetText()
+}
+
+// GetTerminalsOfTree
+// Collects every token found under the given tree in the order they appear in the file
+func GetTerminalsOfTree(tree antlr.Tree) []antlr.Token {
+	var tokens []antlr.Token
+	if terminal, ok := tree.(antlr.TerminalNode); ok {
+		if terminal.GetSymbol().GetTokenType() != antlr.TokenEOF {
+			tokens = append(tokens, terminal.GetSymbol())
+		}
+		return tokens
+	}
+	for _, child := range tree.GetChildren() {
+		tokens = append(tokens, GetTerminalsOfTree(child)...)
+	}
+	return tokens
+}
+
+// isElseIf
+// Checks if the if statement given is the statement directly after an else
+func isElseIf(ctx *parser.IfStatementContext) bool {
+	statement, ok := ctx.GetParent().(*parser.StatementContext)
+	if !ok {
+		return false
+	}
+	parentIf, ok := statement.GetParent().(*parser.IfStatementContext)
+	if !ok || parentIf.Else() == nil {
+		return false
+	}
+	return parentIf.Statement(1) == statement
+}
+
+// hasElseIf
+// Checks if the else of the given if statement is immediately followed by another if
+func hasElseIf(ctx *parser.IfStatementContext) bool {
+	if ctx.Else() == nil || ctx.Statement(1) == nil {
+		return false
+	}
+	elseStatement, ok := ctx.Statement(1).(*parser.StatementContext)
+	return ok && elseStatement.IfStatement() != nil
+}
+
+// getCalledName
+// Gets the name of the function being called, such as "foo" for foo() and this.foo().
+// Anything more complex than that returns an empty string
+func getCalledName(ctx *parser.ArgumentsExpressionContext) string {
+	switch callee := ctx.SingleExpression().(type) {
+	case *parser.IdentifierExpressionContext:
+		if callee.IdentifierName() != nil {
+			return callee.IdentifierName().GetText()
+		}
+	case *parser.MemberDotExpressionContext:
+		if callee.SingleExpression() != nil && callee.SingleExpression().GetText() == "this" && callee.IdentifierName() != nil {
+			return callee.IdentifierName().GetText()
+		}
+	}
+	return ""
+}
+
+// getPromiseLink
+// Gets then, catch or finally when the call is one of them, such as the .then(show) of fetch(url).then(show)
+func getPromiseLink(ctx *parser.ArgumentsExpressionContext) string {
+	member, ok := ctx.SingleExpression().(*parser.MemberDotExpressionContext)
+	if !ok || member.IdentifierName() == nil {
+		return ""
+	}
+	switch name := member.IdentifierName().GetText(); name {
+	case "then", "catch", "finally":
+		return name
+	}
+	return ""
+}
+
+// getPromiseChain
+// Goes down a chain such as fetch(url).then(show).catch(report) from its last call, counting the links
+// and checking if a rejection is handled by a catch or by the second argument of a then
+func getPromiseChain(ctx *parser.ArgumentsExpressionContext) (int, bool) {
+	length, handled := 0, false
+	for ctx != nil {
+		link := getPromiseLink(ctx)
+		if link == "" {
+			break
+		}
+		length++
+		if link == "catch" || (link == "then" && getArgumentCount(ctx) > 1) {
+			handled = true
+		}
+		ctx, _ = ctx.SingleExpression().(*parser.MemberDotExpressionContext).SingleExpression().(*parser.ArgumentsExpressionContext)
+	}
+	return length, handled
+}
+
+func getArgumentCount(ctx *parser.ArgumentsExpressionContext) int {
+	arguments, ok := ctx.Arguments().(*parser.ArgumentsContext)
+	if !ok {
+		return 0
+	}
+	argumentList, ok := arguments.ArgumentList().(*parser.ArgumentListContext)
+	if !ok {
+		return 0
+	}
+	return len(argumentList.AllArgument())
+}
+
+// getStatementCall
+// Gets the call when a statement is nothing but a call, such as save(user);
+func getStatementCall(ctx *parser.ExpressionStatementContext) (*parser.ArgumentsExpressionContext, bool) {
+	sequence, ok := ctx.ExpressionSequence().(*parser.ExpressionSequenceContext)
+	if !ok || len(sequence.AllSingleExpression()) != 1 {
+		return nil, false
+	}
+	call, ok := sequence.SingleExpression(0).(*parser.ArgumentsExpressionContext)
+	return call, ok
+}
+
+// getRequiredModule
+// Gets the module of a require('module') call. Calls with anything but a string, such as require(name), are skipped
+func getRequiredModule(ctx *parser.ArgumentsExpressionContext) (string, bool) {
+	callee, ok := ctx.SingleExpression().(*parser.IdentifierExpressionContext)
+	if !ok || callee.GetText() != "require" {
+		return "", false
+	}
+	arguments, ok := ctx.Arguments().(*parser.ArgumentsContext)
+	if !ok {
+		return "", false
+	}
+	argumentList, ok := arguments.ArgumentList().(*parser.ArgumentListContext)
+	if !ok || len(argumentList.AllArgument()) != 1 {
+		return "", false
+	}
+	argument := argumentList.AllArgument()[0].(*parser.ArgumentContext)
+	literalExpression, ok := argument.SingleExpression().(*parser.LiteralExpressionContext)
+	if !ok {
+		return "", false
+	}
+	literal, ok := literalExpression.Literal().(*parser.LiteralContext)
+	if !ok || literal.StringLiteral() == nil {
+		return "", false
+	}
+	return getStringLiteralValue(literal.StringLiteral().GetText()), true
+}
+
+// getFromBlockModule
+// Gets the module of the from 'module' ending an import or export
+func getFromBlockModule(fromBlock parser.IFromBlockContext) (string, bool) {
+	block, ok := fromBlock.(*parser.FromBlockContext)
+	if !ok || block.StringLiteral() == nil {
+		return "", false
+	}
+	return getStringLiteralValue(block.StringLiteral().GetText()), true
+}
+
+// getStringLiteralValue
+// Takes the quotes off of a string literal. Module names have no escapes worth decoding
+func getStringLiteralValue(text string) string {
+	if len(text) < 2 {
+		return text
+	}
+	return text[1 : len(text)-1]
+}
+
+// GetFunctionName
+// Arrow functions and function expressions usually don't have a name of their own,
+// so one is made from whatever they are bound to:
+// the variable (const handler = () => {}), the property key ({ onClick: () => {} }),
+// the left side of an assignment (this.handler = function () {}) or the call they
+// are passed to (items.map(x => x * 2) becomes "items.map callback")
+func GetFunctionName(ctx antlr.ParserRuleContext) string {
+	if functionExpression, ok := ctx.(*parser.FunctionExpressionDeclarationContext); ok && functionExpression.Identifier() != nil {
+		return functionExpression.Identifier().GetText()
+	}
+	if generator, ok := ctx.(*parser.GeneratorFunctionDeclarationContext); ok && generator.Identifier() != nil {
+		return generator.Identifier().GetText()
+	}
+
+	parent := ctx.GetParent()
+	for isFunctionWrapper(parent) {
+		parent = parent.GetParent()
+	}
+
+	switch binding := parent.(type) {
+	case *parser.VariableDeclarationContext:
+		if binding.IdentifierOrKeyWord() != nil {
+			return binding.IdentifierOrKeyWord().GetText()
+		}
+	case *parser.AssignmentExpressionContext:
+		if binding.SingleExpression(0) != nil {
+			return binding.SingleExpression(0).GetText()
+		}
+	case *parser.PropertyExpressionAssignmentContext:
+		if binding.PropertyName() != nil {
+			return binding.PropertyName().GetText()
+		}
+	case *parser.PropertyDeclarationExpressionContext:
+		if binding.PropertyName() != nil {
+			return binding.PropertyName().GetText()
+		}
+	case *parser.ArgumentContext:
+		if callee := getCalleeOfArgument(binding); callee != "" {
+			return callee + " callback"
+		}
+	}
+	return "lambda"
+}
+
+// isCallback
+// Checks if the function is passed straight to a call, such as the arrow function of items.map(x => x * 2)
+func isCallback(ctx antlr.ParserRuleContext) bool {
+	parent := ctx.GetParent()
+	for isFunctionWrapper(parent) {
+		parent = parent.GetParent()
+	}
+	_, isArgument := parent.(*parser.ArgumentContext)
+	return isArgument
+}
+
+// isFunctionWrapper
+// Nodes that sit between a function and what it is bound to without changing the meaning
+func isFunctionWrapper(tree antlr.Tree) bool {
+	switch tree.(type) {
+	case *parser.ArrowFunctionExpressionContext,
+		*parser.FunctionExpressionContext,
+		*parser.GeneratorsFunctionExpressionContext,
+		*parser.ParenthesizedExpressionContext,
+		*parser.ExpressionSequenceContext,
+		*parser.InitializerContext:
+		return true
+	}
+	return false
+}
+
+// getCalleeOfArgument
+// Goes from an argument up to the call it is in and returns the text of what is being called
+func getCalleeOfArgument(argument *parser.ArgumentContext) string {
+	argumentList, ok := argument.GetParent().(*parser.ArgumentListContext)
+	if !ok || argumentList.GetParent() == nil {
+		return ""
+	}
+	switch call := argumentList.GetParent().GetParent().(type) {
+	case *parser.ArgumentsExpressionContext:
+		if call.SingleExpression() != nil {
+			return getSourceTextUntil(getCallHead(call), call.SingleExpression().GetStop())
+		}
+	case *parser.NewExpressionContext:
+		if call.SingleExpression() != nil {
+			return "new " + getSourceText(call.SingleExpression())
+		}
+	}
+	return ""
+}
+
+// getCallHead
+// The grammar reads a call with type arguments (fetch<T>(url).then(cb)) as the name, then the type arguments,
+// then the rest of the call without its name, so the name is looked for above the call
+func getCallHead(call *parser.ArgumentsExpressionContext) antlr.Tree {
+	sequence, ok := call.GetParent().(*parser.ExpressionSequenceContext)
+	if !ok || sequence.GetStart() != call.GetStart() {
+		return call
+	}
+	generic, ok := sequence.GetParent().(*parser.GenericTypesContext)
+	if !ok {
+		return call
+	}
+	if identifier, ok := generic.GetParent().(*parser.IdentifierExpressionContext); ok {
+		return identifier
+	}
+	return call
+}
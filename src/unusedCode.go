@@ -0,0 +1,62 @@
+package graderFactory
+
+import (
+	"SubmissionGrader/internal/common"
+	"SubmissionGrader/internal/complexity/complexCommons"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const deadCodeJSON = "/dead-code.json"
+
+// FindTypescriptDeadCode
+// Finds the declarations of the parsed files that nothing uses. Test files are entry points,
+// what they use counts but what they declare is not reported
+func FindTypescriptDeadCode(files []complexCommons.FileParseResult) complexCommons.DeadCodeReport {
+	declarations := make([]complexCommons.FileDeclarations, 0, len(files))
+	for _, file := range files {
+		fileDeclarations := file.Declarations
+		fileDeclarations.EntryPoint = isTestFile(file.FileName)
+		declarations = append(declarations, fileDeclarations)
+	}
+	return complexCommons.FindDeadCode(declarations)
+}
+
+// isTestFile
+// Jest finds tests by their name (stack.test.ts, stack.spec.ts) or by their directory
+func isTestFile(fileName string) bool {
+	name := filepath.Base(fileName)
+	if strings.Contains(name, ".test.") || strings.Contains(name, ".spec.") {
+		return true
+	}
+	return strings.HasPrefix(fileName, "src/test/") || strings.Contains("/"+fileName, "/__tests__/")
+}
+
+// writeDeadCodeReport
+// Writes the report as indented JSON next to the submission
+func writeDeadCodeReport(directory string, report complexCommons.DeadCodeReport) error {
+	content, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	const permission = 0777
+	return os.WriteFile(directory+deadCodeJSON, content, permission)
+}
+
+// logDeadCode
+// Reports every unused declaration and unreachable statement
+func logDeadCode(report complexCommons.DeadCodeReport) {
+	for _, unused := range report.Unused {
+		name := unused.Name
+		if unused.Class != "" {
+			name = unused.Class + "." + name
+		}
+		common.Debug(fmt.Sprintf("%s %s is never used (%s:%d)", unused.Kind, name, unused.FileName, unused.Line))
+	}
+	for _, unreachable := range report.Unreachable {
+		common.Debug(fmt.Sprintf("Code after %s can never run (%s:%d)", unreachable.After, unreachable.FileName, unreachable.Line))
+	}
+}
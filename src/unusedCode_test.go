@@ -0,0 +1,75 @@
+package graderFactory
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFindTypescriptDeadCode(t *testing.T) {
+	root := writeSubmission(t, map[string]string{
+		"src/stack.ts": `import { sum } from './math';
+
+export class Stack {
+  private items: number[] = [];
+
+  push(item: number): void {
+    this.items.push(item);
+  }
+
+  total(): number {
+    return sum(this.items);
+    this.items = [];
+  }
+}
+
+function unusedHelper(): number {
+  return 0;
+}
+`,
+		"src/math.ts": `export function sum(items: number[]): number {
+  return items.reduce((a, b) => a + b, 0);
+}
+
+export function average(items: number[]): number {
+  return sum(items) / items.length;
+}
+`,
+		"src/main.ts": `import { Stack } from './stack';
+
+function report(stack: Stack) {
+  console.log(stack);
+}
+
+const stack = new Stack();
+stack.push(1);
+report(stack);
+`,
+		"src/stack.test.ts": `import { Stack } from './stack';
+
+function makeStack(): Stack {
+  return new Stack();
+}
+test('push', () => makeStack().push(1));
+`,
+	})
+
+	files, err := ParseTypescriptSubmission(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := FindTypescriptDeadCode(files)
+
+	unused := []string{}
+	for _, declaration := range report.Unused {
+		unused = append(unused, fmt.Sprintf("%s:%d %s", declaration.FileName, declaration.Line, declaration.Name))
+	}
+	// push is called by the test, what the test declares itself is never reported.
+	// average is exported, the call statement report(stack) uses report without declaring it again
+	want := []string{"src/stack.ts:10 total", "src/stack.ts:16 unusedHelper"}
+	if fmt.Sprint(unused) != fmt.Sprint(want) {
+		t.Errorf("unused = %v, want %v", unused, want)
+	}
+	if len(report.Unreachable) != 1 || report.Unreachable[0].FileName != "src/stack.ts" || report.Unreachable[0].Line != 12 {
+		t.Errorf("unreachable = %+v, want line 12 of src/stack.ts", report.Unreachable)
+	}
+}